@@ -124,6 +124,12 @@ type Operation struct {
 	Variables            map[string]arguments.UnparsedVariableValue
 	StatePersistInterval int
 
+	// ResourceCreateBudget, if positive, limits the number of resource
+	// instances a plan operation is allowed to create; see
+	// arguments.Operation.ResourceCreateBudget for the rationale. Zero means
+	// no limit.
+	ResourceCreateBudget int
+
 	// Some operations use root module variables only opportunistically or
 	// don't need them at all. If this flag is set, the backend must treat
 	// all variables as optional and provide an unknown value for any required
@@ -145,6 +145,16 @@ func (b *Local) opPlan(
 		return
 	}
 
+	// If the operation requested a limit on the number of resources a plan
+	// is allowed to create, enforce it now while we still have the
+	// fully-assembled plan in hand.
+	budgetDiags := plan.CheckResourceCreateBudget(op.ResourceCreateBudget)
+	diags = diags.Append(budgetDiags)
+	if budgetDiags.HasErrors() {
+		op.ReportResult(runningOp, diags)
+		return
+	}
+
 	// Record whether this plan includes any side-effects that could be applied.
 	runningOp.PlanEmpty = !plan.Applyable
 
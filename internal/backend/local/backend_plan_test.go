@@ -58,6 +58,31 @@ func TestLocal_planBasic(t *testing.T) {
 	}
 }
 
+func TestLocal_planResourceCreateBudgetExceeded(t *testing.T) {
+	b := TestLocal(t)
+	TestLocalProvider(t, b, "test", planFixtureSchema())
+
+	// testdata/apply-error plans two resource creates, so a budget of 1
+	// should be rejected even though nothing here actually fails to apply.
+	op, configCleanup, done := testOperationPlan(t, "./testdata/apply-error")
+	defer configCleanup()
+	op.PlanRefresh = true
+	op.ResourceCreateBudget = 1
+
+	run, err := b.Operation(context.Background(), op)
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+	<-run.Done()
+	if run.Result == backendrun.OperationSuccess {
+		t.Fatal("plan operation should have failed")
+	}
+
+	if errOutput := done(t).Stderr(); !strings.Contains(errOutput, "resource creation budget") {
+		t.Fatalf("expected a resource creation budget error, got:\n%s", errOutput)
+	}
+}
+
 func TestLocal_planInAutomation(t *testing.T) {
 	b := TestLocal(t)
 	TestLocalProvider(t, b, "test", planFixtureSchema())
@@ -20,6 +20,12 @@ type Apply struct {
 	// AutoApprove skips the manual verification step for the apply operation.
 	AutoApprove bool
 
+	// ConfirmDestroy requests an additional interactive confirmation
+	// prompt immediately before each individual destroy, on top of the
+	// usual one-time confirmation for the plan as a whole. AutoApprove
+	// skips these prompts along with the main one.
+	ConfirmDestroy bool
+
 	// InputEnabled is used to disable interactive input for unspecified
 	// variable and backend config values. Default is true.
 	InputEnabled bool
@@ -48,6 +54,7 @@ func ParseApply(args []string) (*Apply, tfdiags.Diagnostics) {
 
 	cmdFlags := extendedFlagSet("apply", apply.State, apply.Operation, apply.Vars)
 	cmdFlags.BoolVar(&apply.AutoApprove, "auto-approve", false, "auto-approve")
+	cmdFlags.BoolVar(&apply.ConfirmDestroy, "confirm-destroy", false, "confirm-destroy")
 	cmdFlags.BoolVar(&apply.InputEnabled, "input", true, "input")
 	cmdFlags.Var((*FlagStringSlice)(&apply.PolicyPaths), "policies", "policies")
 
@@ -100,6 +107,17 @@ func ParseApply(args []string) (*Apply, tfdiags.Diagnostics) {
 		))
 	}
 
+	// The per-resource destroy confirmation is an interactive prompt, so it
+	// doesn't make sense in combination with -json, which can't ask for
+	// interactive approval at all.
+	if json && apply.ConfirmDestroy {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Confirm destroy requires interactive approval",
+			"The -confirm-destroy option cannot be used with -json, because Terraform cannot ask for interactive approval when -json is set.",
+		))
+	}
+
 	diags = diags.Append(apply.Operation.Parse())
 
 	switch {
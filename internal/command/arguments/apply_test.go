@@ -139,6 +139,46 @@ func TestParseApply_json(t *testing.T) {
 	}
 }
 
+func TestParseApply_confirmDestroy(t *testing.T) {
+	testCases := map[string]struct {
+		args        []string
+		wantSuccess bool
+	}{
+		"-confirm-destroy": {
+			[]string{"-confirm-destroy"},
+			true,
+		},
+		"-confirm-destroy -auto-approve": {
+			[]string{"-confirm-destroy", "-auto-approve"},
+			true,
+		},
+		"-json -confirm-destroy": {
+			[]string{"-json", "-confirm-destroy", "-auto-approve"},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, diags := ParseApply(tc.args)
+
+			if !got.ConfirmDestroy {
+				t.Errorf("expected ConfirmDestroy to be true")
+			}
+
+			if tc.wantSuccess {
+				if diags.HasErrors() {
+					t.Errorf("unexpected diags: %v", diags)
+				}
+			} else {
+				if got, want := diags.Err().Error(), "Confirm destroy requires interactive approval"; !strings.Contains(got, want) {
+					t.Errorf("wrong diags\n got: %s\nwant: %s", got, want)
+				}
+			}
+		})
+	}
+}
+
 func TestParseApply_invalid(t *testing.T) {
 	got, diags := ParseApply([]string{"-frob"})
 	if len(diags) == 0 {
@@ -72,6 +72,15 @@ type Operation struct {
 	// and this should only be set for plan and apply operations.
 	ActionTargets []addrs.Targetable
 
+	// ResourceCreateBudget, if positive, limits the number of resource
+	// instances that a plan is allowed to create. Exceeding the budget is an
+	// error, so that a configuration change that would create far more
+	// objects than expected (for example, a for_each driven by a data source
+	// that silently returned far more elements than intended) is caught
+	// during plan rather than partway through apply. Zero, the default,
+	// means no limit.
+	ResourceCreateBudget int
+
 	// ForceReplace addresses cause Terraform to force a particular set of
 	// resource instances to generate "replace" actions in any plan where they
 	// would normally have generated "no-op" or "update" actions.
@@ -253,6 +262,7 @@ func extendedFlagSet(name string, state *State, operation *Operation, vars *Vars
 
 	if operation != nil {
 		f.IntVar(&operation.Parallelism, "parallelism", DefaultParallelism, "parallelism")
+		f.IntVar(&operation.ResourceCreateBudget, "resource-create-budget", 0, "resource-create-budget")
 		f.BoolVar(&operation.DeferralAllowed, "allow-deferral", false, "allow-deferral")
 		f.BoolVar(&operation.Refresh, "refresh", true, "refresh")
 		f.BoolVar(&operation.destroyRaw, "destroy", false, "destroy")
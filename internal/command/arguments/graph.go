@@ -25,7 +25,7 @@ type Graph struct {
 	// Plan is the path to a saved plan file to render as a graph.
 	Plan string
 
-	// Format is the output format to emit (dot or mermaid).
+	// Format is the output format to emit (dot, mermaid, or json).
 	Format string
 
 	// Vars are the variable-related flags (-var, -var-file).
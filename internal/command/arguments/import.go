@@ -48,6 +48,15 @@ type Import struct {
 
 	// ID is the provider-specific ID of the resource to import.
 	ID string
+
+	// FromFile, if set, is the path to a JSON file listing multiple
+	// resources to import in one operation, as an alternative to passing a
+	// single ADDR and ID on the command line.
+	FromFile string
+
+	// Preview, if set, causes the command to print the state that importing
+	// would produce without writing it to the state file.
+	Preview bool
 }
 
 // ParseImport processes CLI arguments, returning an Import value and errors.
@@ -78,6 +87,8 @@ func ParseImport(args []string) (*Import, tfdiags.Diagnostics) {
 	cmdFlags.BoolVar(&imp.InputEnabled, "input", true, "input")
 	cmdFlags.BoolVar(&imp.CompactWarnings, "compact-warnings", false, "compact-warnings")
 	cmdFlags.Var((*FlagStringSlice)(&imp.TargetFlags), "target", "target")
+	cmdFlags.StringVar(&imp.FromFile, "from-file", "", "from-file")
+	cmdFlags.BoolVar(&imp.Preview, "preview", false, "preview")
 
 	if err := cmdFlags.Parse(args); err != nil {
 		diags = diags.Append(tfdiags.Sourceless(
@@ -89,18 +100,23 @@ func ParseImport(args []string) (*Import, tfdiags.Diagnostics) {
 
 	args = cmdFlags.Args()
 
-	if len(args) != 2 {
+	switch {
+	case imp.FromFile != "":
+		if len(args) != 0 {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Too many arguments",
+				"The import command does not accept ADDR and ID arguments when -from-file is set.",
+			))
+		}
+	case len(args) != 2:
 		diags = diags.Append(tfdiags.Sourceless(
 			tfdiags.Error,
 			"Wrong number of arguments",
 			"The import command expects two arguments: ADDR and ID.",
 		))
-	}
-
-	if len(args) > 0 {
+	default:
 		imp.Addr = args[0]
-	}
-	if len(args) > 1 {
 		imp.ID = args[1]
 	}
 
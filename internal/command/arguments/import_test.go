@@ -114,6 +114,28 @@ func TestParseImport_valid(t *testing.T) {
 				ID:           "bar",
 			},
 		},
+		"from file": {
+			[]string{"-from-file=identities.json"},
+			&Import{
+				State:        &State{Lock: true},
+				Vars:         &Vars{},
+				Parallelism:  DefaultParallelism,
+				InputEnabled: true,
+				FromFile:     "identities.json",
+			},
+		},
+		"preview": {
+			[]string{"-preview", "test_instance.foo", "bar"},
+			&Import{
+				State:        &State{Lock: true},
+				Vars:         &Vars{},
+				Parallelism:  DefaultParallelism,
+				InputEnabled: true,
+				Addr:         "test_instance.foo",
+				ID:           "bar",
+				Preview:      true,
+			},
+		},
 	}
 
 	cmpOpts := cmpopts.IgnoreUnexported(Vars{}, State{})
@@ -188,6 +210,23 @@ func TestParseImport_invalid(t *testing.T) {
 				),
 			},
 		},
+		"from file with extra arguments": {
+			[]string{"-from-file=identities.json", "test_instance.foo"},
+			&Import{
+				State:        &State{Lock: true},
+				Vars:         &Vars{},
+				Parallelism:  DefaultParallelism,
+				InputEnabled: true,
+				FromFile:     "identities.json",
+			},
+			tfdiags.Diagnostics{
+				tfdiags.Sourceless(
+					tfdiags.Error,
+					"Too many arguments",
+					"The import command does not accept ADDR and ID arguments when -from-file is set.",
+				),
+			},
+		},
 		"too many arguments": {
 			[]string{"test_instance.foo", "bar", "baz"},
 			&Import{
@@ -36,6 +36,11 @@ type Plan struct {
 	// PolicyPath contains an optional path to any defined policies that should
 	// be applied for this plan operation.
 	PolicyPaths []string
+
+	// OutputPlugin optionally names a registered jsonformat.OutputPlugin to
+	// use for rendering the human-readable plan output, in place of
+	// Terraform's own format.
+	OutputPlugin string
 }
 
 // ParsePlan processes CLI arguments, returning a Plan value and errors.
@@ -55,6 +60,7 @@ func ParsePlan(args []string) (*Plan, tfdiags.Diagnostics) {
 	cmdFlags.StringVar(&plan.OutPath, "out", "", "out")
 	cmdFlags.StringVar(&plan.GenerateConfigPath, "generate-config-out", "", "generate-config-out")
 	cmdFlags.Var((*FlagStringSlice)(&plan.PolicyPaths), "policies", "policies")
+	cmdFlags.StringVar(&plan.OutputPlugin, "output-plugin", "", "output-plugin")
 
 	var json bool
 	cmdFlags.BoolVar(&json, "json", false, "json")
@@ -50,6 +50,23 @@ func TestParsePlan_basicValid(t *testing.T) {
 				},
 			},
 		},
+		"setting resource-create-budget": {
+			[]string{"-resource-create-budget=5"},
+			&Plan{
+				DetailedExitCode: false,
+				InputEnabled:     true,
+				OutPath:          "",
+				ViewType:         ViewHuman,
+				State:            &State{Lock: true},
+				Vars:             &Vars{},
+				Operation: &Operation{
+					PlanMode:             plans.NormalMode,
+					Parallelism:          10,
+					Refresh:              true,
+					ResourceCreateBudget: 5,
+				},
+			},
+		},
 		"JSON view disables input": {
 			[]string{"-json"},
 			&Plan{
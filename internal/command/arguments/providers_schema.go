@@ -10,6 +10,11 @@ import "github.com/hashicorp/terraform/internal/tfdiags"
 type ProvidersSchema struct {
 	JSON bool
 
+	// Completion, if set, requests that the schema be exported in a format
+	// optimized for configuration autocompletion tooling instead of the
+	// full schema representation.
+	Completion bool
+
 	// Vars are the variable-related flags (-var, -var-file).
 	Vars *Vars
 }
@@ -25,6 +30,7 @@ func ParseProvidersSchema(args []string) (*ProvidersSchema, tfdiags.Diagnostics)
 
 	cmdFlags := extendedFlagSet("providers schema", nil, nil, providersSchema.Vars)
 	cmdFlags.BoolVar(&providersSchema.JSON, "json", false, "produce JSON output")
+	cmdFlags.BoolVar(&providersSchema.Completion, "completion", false, "produce JSON output optimized for autocompletion tooling")
 
 	if err := cmdFlags.Parse(args); err != nil {
 		diags = diags.Append(tfdiags.Sourceless(
@@ -43,7 +49,7 @@ func ParseProvidersSchema(args []string) (*ProvidersSchema, tfdiags.Diagnostics)
 		))
 	}
 
-	if !providersSchema.JSON {
+	if !providersSchema.JSON && !providersSchema.Completion {
 		diags = diags.Append(tfdiags.Sourceless(
 			tfdiags.Error,
 			"The -json flag is required",
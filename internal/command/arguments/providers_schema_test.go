@@ -23,6 +23,13 @@ func TestParseProvidersSchema_valid(t *testing.T) {
 				Vars: &Vars{},
 			},
 		},
+		"completion": {
+			[]string{"-completion"},
+			&ProvidersSchema{
+				Completion: true,
+				Vars:       &Vars{},
+			},
+		},
 	}
 
 	cmpOpts := cmpopts.IgnoreUnexported(Vars{})
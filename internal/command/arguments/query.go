@@ -22,6 +22,11 @@ type Query struct {
 	// be written to.
 	GenerateConfigPath string
 
+	// OutFile, if set, tells Terraform to stream each list block's results
+	// to the given file as they arrive, in addition to the normal
+	// terminal/JSON output.
+	OutFile string
+
 	// EXPERIMENTAL
 	// PolicyPaths contains optional paths to policy set directories that should
 	// be evaluated during this query operation.
@@ -38,6 +43,7 @@ func ParseQuery(args []string) (*Query, tfdiags.Diagnostics) {
 
 	cmdFlags := defaultFlagSet("query")
 	cmdFlags.StringVar(&query.GenerateConfigPath, "generate-config-out", "", "generate-config-out")
+	cmdFlags.StringVar(&query.OutFile, "out-file", "", "out-file")
 
 	varsFlags := NewFlagNameValueSlice("-var")
 	varFilesFlags := varsFlags.Alias("-var-file")
@@ -44,3 +44,35 @@ func TestParseQuery_policies(t *testing.T) {
 		})
 	}
 }
+
+func TestParseQuery_outFile(t *testing.T) {
+	testCases := map[string]struct {
+		args        []string
+		wantOutFile string
+	}{
+		"flag omitted": {
+			args:        nil,
+			wantOutFile: "",
+		},
+		"equals syntax": {
+			args:        []string{"-out-file=results.jsonl"},
+			wantOutFile: "results.jsonl",
+		},
+		"space syntax": {
+			args:        []string{"-out-file", "results.jsonl"},
+			wantOutFile: "results.jsonl",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, diags := ParseQuery(tc.args)
+			if diags.HasErrors() {
+				t.Fatalf("unexpected diags: %v", diags)
+			}
+			if got.OutFile != tc.wantOutFile {
+				t.Errorf("wrong OutFile\ngot:  %q\nwant: %q", got.OutFile, tc.wantOutFile)
+			}
+		})
+	}
+}
@@ -4,6 +4,9 @@
 package arguments
 
 import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/internal/command/jsonformat"
 	"github.com/hashicorp/terraform/internal/tfdiags"
 )
 
@@ -16,6 +19,22 @@ type Show struct {
 	// ViewType specifies which output format to use: human, JSON, or "raw".
 	ViewType ViewType
 
+	// Deferred, when set, restricts output to a short summary of just the
+	// deferred resources in a plan file and their deferral reasons, instead
+	// of rendering the full plan.
+	Deferred bool
+
+	// DeferredGranularity controls, when Deferred is set and the output is
+	// in human format, whether that summary reports one line per deferred
+	// resource instance or coalesces instances of the same resource into
+	// one line.
+	DeferredGranularity jsonformat.DeferredGranularity
+
+	// Normalized, when set alongside JSON output, restricts output to the
+	// small, version-stable summary of a plan's changes produced by the
+	// normalizeddiff package, instead of the full JSON plan representation.
+	Normalized bool
+
 	Vars *Vars
 }
 
@@ -30,8 +49,12 @@ func ParseShow(args []string) (*Show, tfdiags.Diagnostics) {
 	}
 
 	var jsonOutput bool
+	var deferredGranularity string
 	cmdFlags := extendedFlagSet("show", nil, nil, show.Vars)
 	cmdFlags.BoolVar(&jsonOutput, "json", false, "json")
+	cmdFlags.BoolVar(&show.Deferred, "deferred", false, "deferred")
+	cmdFlags.StringVar(&deferredGranularity, "deferred-granularity", "instance", "deferred-granularity")
+	cmdFlags.BoolVar(&show.Normalized, "normalized", false, "normalized")
 
 	if err := cmdFlags.Parse(args); err != nil {
 		diags = diags.Append(tfdiags.Sourceless(
@@ -41,6 +64,19 @@ func ParseShow(args []string) (*Show, tfdiags.Diagnostics) {
 		))
 	}
 
+	switch deferredGranularity {
+	case "instance":
+		show.DeferredGranularity = jsonformat.DeferredGranularityInstance
+	case "resource":
+		show.DeferredGranularity = jsonformat.DeferredGranularityResource
+	default:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid value for -deferred-granularity",
+			fmt.Sprintf("The -deferred-granularity flag must be either \"instance\" or \"resource\", not %q.", deferredGranularity),
+		))
+	}
+
 	args = cmdFlags.Args()
 	if len(args) > 1 {
 		diags = diags.Append(tfdiags.Sourceless(
@@ -61,5 +97,13 @@ func ParseShow(args []string) (*Show, tfdiags.Diagnostics) {
 		show.ViewType = ViewHuman
 	}
 
+	if show.Normalized && !jsonOutput {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid combination of arguments",
+			"The -normalized flag requires -json.",
+		))
+	}
+
 	return show, diags
 }
@@ -40,6 +40,15 @@ func TestParseShow_valid(t *testing.T) {
 				Vars:     &Vars{},
 			},
 		},
+		"normalized": {
+			[]string{"-json", "-normalized"},
+			&Show{
+				Path:       "",
+				ViewType:   ViewJSON,
+				Normalized: true,
+				Vars:       &Vars{},
+			},
+		},
 	}
 
 	cmpOpts := cmpopts.IgnoreUnexported(Operation{}, Vars{}, State{})
@@ -78,6 +87,22 @@ func TestParseShow_invalid(t *testing.T) {
 				),
 			},
 		},
+		"normalized without json": {
+			[]string{"-normalized"},
+			&Show{
+				Path:       "",
+				ViewType:   ViewHuman,
+				Normalized: true,
+				Vars:       &Vars{},
+			},
+			tfdiags.Diagnostics{
+				tfdiags.Sourceless(
+					tfdiags.Error,
+					"Invalid combination of arguments",
+					"The -normalized flag requires -json.",
+				),
+			},
+		},
 		"too many arguments": {
 			[]string{"-json", "bar", "baz"},
 			&Show{
@@ -5,6 +5,7 @@ package command
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -124,6 +125,8 @@ func (c *GraphCommand) Run(rawArgs []string) int {
 			switch args.Format {
 			case "mermaid":
 				return c.resourceOnlyGraphMermaid(g)
+			case "json":
+				return c.resourceOnlyGraphJSON(g)
 			case "", "dot":
 				return c.resourceOnlyGraph(g)
 			default:
@@ -195,6 +198,8 @@ func (c *GraphCommand) Run(rawArgs []string) int {
 	switch args.Format {
 	case "mermaid":
 		graphStr, err = terraform.GraphMermaid(g, opts)
+	case "json":
+		graphStr, err = terraform.GraphJSON(g)
 	case "", "dot":
 		graphStr, err = terraform.GraphDot(g, opts)
 	default:
@@ -386,6 +391,67 @@ func (c *GraphCommand) resourceOnlyGraphMermaid(graph addrs.DirectedGraph[addrs.
 	return 0
 }
 
+// graphJSON is the document written by resourceOnlyGraphJSON, describing
+// the resource dependency graph as a flat list of nodes and edges for
+// machine consumption.
+//
+// This only covers the simple resource-dependency graph produced without
+// -type=..., since that's the addrs.DirectedGraph representation available
+// at this point. It doesn't include provider nodes and so has no way to
+// represent deferral relationships arising from an unknown provider
+// configuration, which is a concept tracked only inside the stacks runtime
+// and isn't visible to this command.
+type graphJSON struct {
+	Nodes []string        `json:"nodes"`
+	Edges []graphJSONEdge `json:"edges"`
+}
+
+type graphJSONEdge struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+func (c *GraphCommand) resourceOnlyGraphJSON(graph addrs.DirectedGraph[addrs.ConfigResource]) int {
+	out := c.Streams.Stdout.File
+
+	allAddrs := graph.AllNodes()
+	addrsOrder := make([]addrs.ConfigResource, 0, len(allAddrs))
+	for _, addr := range allAddrs {
+		addrsOrder = append(addrsOrder, addr)
+	}
+	sort.Slice(addrsOrder, func(i, j int) bool {
+		return addrsOrder[i].String() < addrsOrder[j].String()
+	})
+
+	doc := graphJSON{
+		Nodes: make([]string, 0, len(addrsOrder)),
+		Edges: make([]graphJSONEdge, 0),
+	}
+	for _, addr := range addrsOrder {
+		doc.Nodes = append(doc.Nodes, addr.String())
+	}
+	for _, sourceAddr := range addrsOrder {
+		deps := graph.DirectDependenciesOf(sourceAddr)
+		for _, targetAddr := range addrsOrder {
+			if !deps.Has(targetAddr) {
+				continue
+			}
+			doc.Edges = append(doc.Edges, graphJSONEdge{
+				Source: sourceAddr.String(),
+				Target: targetAddr.String(),
+			})
+		}
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to encode graph as JSON: %s", err))
+		return 1
+	}
+	return 0
+}
+
 func (c *GraphCommand) Help() string {
 	helpText := `
 Usage: terraform [global options] graph [options]
@@ -433,7 +499,13 @@ Options:
                       variables file.
 
   -format=FORMAT      Output format for the graph. Supported values are
-                      dot (default) and mermaid.
+                      dot (default), mermaid, and json. The json format
+                      describes the graph as a flat list of nodes and
+                      edges, and is only available for the default
+                      resource-dependency summary and the -type=TYPE
+                      operation graphs; it does not include deferral
+                      relationships from unknown provider configurations,
+                      since those are tracked only by the stacks runtime.
 `
 	return strings.TrimSpace(helpText)
 }
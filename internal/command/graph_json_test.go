@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/configs/configload"
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/initwd"
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/registry"
+	"github.com/hashicorp/terraform/internal/terminal"
+)
+
+// TestGraph_resourcesOnly_json is a golden-fixture test for the default
+// (resources-only) graph rendered as JSON.  It uses the same
+// "graph-interesting" fixture as TestGraph_resourcesOnly and
+// TestGraph_resourcesOnly_mermaid so all three tests document equivalent
+// output for the dot, mermaid, and json formats.
+func TestGraph_resourcesOnly_json(t *testing.T) {
+	wd := tempWorkingDirFixture(t, "graph-interesting")
+	t.Chdir(wd.RootModuleDir())
+
+	loader, cleanupLoader := configload.NewLoaderForTests(t)
+	t.Cleanup(cleanupLoader)
+	err := os.MkdirAll(".terraform/modules", 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst := initwd.NewModuleInstaller(".terraform/modules", loader, registry.NewClient(nil, nil), nil)
+	_, instDiags := inst.InstallModules(context.Background(), ".", "tests", true, false)
+	if instDiags.HasErrors() {
+		t.Fatal(instDiags.Err())
+	}
+
+	p := testProvider()
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"foo": {
+				Body: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"arg": {
+							Type:     cty.String,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ui := cli.NewMockUi()
+	streams, closeStreams := terminal.StreamsForTesting(t)
+	c := &GraphCommand{
+		Meta: Meta{
+			testingOverrides: &testingOverrides{
+				Providers: map[addrs.Provider]providers.Factory{
+					addrs.NewDefaultProvider("foo"): providers.FactoryFixed(p),
+				},
+			},
+			Ui:      ui,
+			Streams: streams,
+		},
+	}
+
+	args := []string{"-format=json"}
+	if code := c.Run(args); code != 0 {
+		output := closeStreams(t)
+		t.Fatalf("unexpected error: \n%s", output.Stderr())
+	}
+
+	output := closeStreams(t)
+
+	var got graphJSON
+	if err := json.Unmarshal([]byte(output.Stdout()), &got); err != nil {
+		t.Fatalf("failed to parse output as JSON: %s\n\noutput:\n%s", err, output.Stdout())
+	}
+
+	want := graphJSON{
+		Nodes: []string{
+			"foo.bar",
+			"foo.baz",
+			"foo.boop",
+			"module.child.foo.bleep",
+		},
+		Edges: []graphJSONEdge{
+			{Source: "foo.baz", Target: "foo.bar"},
+			{Source: "foo.boop", Target: "module.child.foo.bleep"},
+			{Source: "module.child.foo.bleep", Target: "foo.bar"},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("wrong JSON graph output\n%s", diff)
+	}
+
+	// The resources-only graph has no concept of providers, and so it has
+	// no way to represent the deferral relationships that the stacks
+	// runtime's unknownProvider stub introduces at a different layer. We
+	// assert that here so that this limitation is documented by a test
+	// rather than relying on a reader noticing the doc comment.
+	if strings.Contains(output.Stdout(), "deferral") {
+		t.Fatalf("unexpected deferral annotation in resources-only graph JSON:\n%s", output.Stdout())
+	}
+}
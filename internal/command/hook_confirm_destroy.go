@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/plans"
+	"github.com/hashicorp/terraform/internal/terraform"
+)
+
+// confirmDestroyHook is a terraform.Hook that, when enabled by the
+// "terraform apply" -confirm-destroy flag, asks for interactive
+// confirmation immediately before each individual destroy, on top of the
+// usual one-time confirmation for the plan as a whole. This gives an
+// operator one last per-resource chance to back out of a destructive
+// change instead of an all-or-nothing decision.
+//
+// It leaves deferred resource instances alone: a deferral (for example, one
+// produced by the unknownProvider stub while a provider configuration is
+// still unknown) never reaches PreApply with a concrete destroy action, so
+// this hook has no effect on those paths.
+type confirmDestroyHook struct {
+	terraform.NilHook
+
+	UIInput terraform.UIInput
+
+	// mu serializes prompts, since PreApply can be called concurrently for
+	// independent resource instances during a graph walk.
+	mu sync.Mutex
+}
+
+var _ terraform.Hook = (*confirmDestroyHook)(nil)
+
+func (h *confirmDestroyHook) PreApply(id terraform.HookResourceIdentity, dk addrs.DeposedKey, action plans.Action, priorState, plannedNewState cty.Value) (terraform.HookAction, error) {
+	switch action {
+	case plans.Delete, plans.CreateThenDelete, plans.DeleteThenCreate:
+		// proceed to prompt below
+	default:
+		return terraform.HookActionContinue, nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	dispAddr := id.Addr.String()
+	if dk != addrs.NotDeposed {
+		dispAddr = fmt.Sprintf("%s (deposed object %s)", dispAddr, dk)
+	}
+
+	v, err := h.UIInput.Input(context.Background(), &terraform.InputOpts{
+		Id:          "confirm-destroy",
+		Query:       fmt.Sprintf("Destroy %s?", dispAddr),
+		Description: "Terraform is about to destroy this resource, as part of the plan you approved.\nOnly 'yes' will be accepted to confirm.",
+	})
+	if err != nil {
+		return terraform.HookActionHalt, fmt.Errorf("error asking for destroy confirmation: %w", err)
+	}
+	if strings.ToLower(strings.TrimSpace(v)) != "yes" {
+		return terraform.HookActionHalt, fmt.Errorf("destroy of %s was not confirmed", dispAddr)
+	}
+
+	return terraform.HookActionContinue, nil
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/plans"
+	"github.com/hashicorp/terraform/internal/terraform"
+)
+
+// fakeConfirmInput is a terraform.UIInput that always answers with a fixed
+// string, for testing confirmDestroyHook without a real terminal.
+type fakeConfirmInput struct {
+	answer string
+	asked  int
+}
+
+func (f *fakeConfirmInput) Input(ctx context.Context, opts *terraform.InputOpts) (string, error) {
+	f.asked++
+	return f.answer, nil
+}
+
+func testConfirmDestroyID(t *testing.T) terraform.HookResourceIdentity {
+	t.Helper()
+	return terraform.HookResourceIdentity{
+		Addr: addrs.Resource{
+			Mode: addrs.ManagedResourceMode,
+			Type: "test_instance",
+			Name: "foo",
+		}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+	}
+}
+
+func TestConfirmDestroyHook_confirmed(t *testing.T) {
+	input := &fakeConfirmInput{answer: "yes"}
+	h := &confirmDestroyHook{UIInput: input}
+
+	action, err := h.PreApply(testConfirmDestroyID(t), addrs.NotDeposed, plans.Delete, cty.NilVal, cty.NilVal)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if action != terraform.HookActionContinue {
+		t.Fatalf("wrong action: got %#v, want HookActionContinue", action)
+	}
+	if input.asked != 1 {
+		t.Fatalf("expected exactly one prompt, got %d", input.asked)
+	}
+}
+
+func TestConfirmDestroyHook_declined(t *testing.T) {
+	input := &fakeConfirmInput{answer: "no"}
+	h := &confirmDestroyHook{UIInput: input}
+
+	action, err := h.PreApply(testConfirmDestroyID(t), addrs.NotDeposed, plans.Delete, cty.NilVal, cty.NilVal)
+	if err == nil {
+		t.Fatal("expected an error for a declined destroy")
+	}
+	if action != terraform.HookActionHalt {
+		t.Fatalf("wrong action: got %#v, want HookActionHalt", action)
+	}
+}
+
+func TestConfirmDestroyHook_nonDestroyActionsSkipped(t *testing.T) {
+	input := &fakeConfirmInput{answer: "no"}
+	h := &confirmDestroyHook{UIInput: input}
+
+	action, err := h.PreApply(testConfirmDestroyID(t), addrs.NotDeposed, plans.Create, cty.NilVal, cty.NilVal)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if action != terraform.HookActionContinue {
+		t.Fatalf("wrong action: got %#v, want HookActionContinue", action)
+	}
+	if input.asked != 0 {
+		t.Fatalf("expected no prompts for a non-destroy action, got %d", input.asked)
+	}
+}
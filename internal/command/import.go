@@ -5,19 +5,26 @@ package command
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
 
 	"github.com/hashicorp/terraform/internal/addrs"
 	"github.com/hashicorp/terraform/internal/backend/backendrun"
 	"github.com/hashicorp/terraform/internal/command/arguments"
+	"github.com/hashicorp/terraform/internal/command/jsonformat"
 	"github.com/hashicorp/terraform/internal/command/views"
 	"github.com/hashicorp/terraform/internal/configs"
+	"github.com/hashicorp/terraform/internal/states"
+	"github.com/hashicorp/terraform/internal/states/statefile"
 	"github.com/hashicorp/terraform/internal/terraform"
 	"github.com/hashicorp/terraform/internal/tfdiags"
 )
@@ -49,29 +56,18 @@ func (c *ImportCommand) Run(args []string) int {
 		return 1
 	}
 
-	// Parse the provided resource address.
-	traversalSrc := []byte(parsedArgs.Addr)
-	traversal, travDiags := hclsyntax.ParseTraversalAbs(traversalSrc, "<import-address>", hcl.Pos{Line: 1, Column: 1})
-	diags = diags.Append(travDiags)
-	if travDiags.HasErrors() {
-		c.registerSynthConfigSource("<import-address>", traversalSrc) // so we can include a source snippet
-		c.showDiagnostics(diags)
-		c.Ui.Info(importCommandInvalidAddressReference)
-		return 1
-	}
-	addr, addrDiags := addrs.ParseAbsResourceInstance(traversal)
-	diags = diags.Append(addrDiags)
-	if addrDiags.HasErrors() {
-		c.registerSynthConfigSource("<import-address>", traversalSrc) // so we can include a source snippet
-		c.showDiagnostics(diags)
-		c.Ui.Info(importCommandInvalidAddressReference)
-		return 1
-	}
-
-	if addr.Resource.Resource.Mode != addrs.ManagedResourceMode {
-		diags = diags.Append(errors.New("A managed resource address is required. Importing into a data resource is not allowed."))
-		c.showDiagnostics(diags)
-		return 1
+	// Parse the provided resource address. When -from-file is set there is
+	// no single ADDR on the command line, so the targets are instead
+	// resolved below, once the configuration and schemas are available.
+	var addr addrs.AbsResourceInstance
+	if parsedArgs.FromFile == "" {
+		var addrDiags tfdiags.Diagnostics
+		addr, addrDiags = c.parseImportAddr(parsedArgs.Addr)
+		diags = diags.Append(addrDiags)
+		if addrDiags.HasErrors() {
+			c.showDiagnostics(diags)
+			return 1
+		}
 	}
 
 	if !c.dirIsConfigPath(parsedArgs.ConfigPath) {
@@ -148,49 +144,14 @@ func (c *ImportCommand) Run(args []string) int {
 	// This is to reduce the risk that a typo in the resource address will
 	// import something that Terraform will want to immediately destroy on
 	// the next plan, and generally acts as a reassurance of user intent.
-	targetConfig := config.DescendantForInstance(addr.Module)
-	if targetConfig == nil {
-		modulePath := addr.Module.String()
-		diags = diags.Append(&hcl.Diagnostic{
-			Severity: hcl.DiagError,
-			Summary:  "Import to non-existent module",
-			Detail: fmt.Sprintf(
-				"%s is not defined in the configuration. Please add configuration for this module before importing into it.",
-				modulePath,
-			),
-		})
-		c.showDiagnostics(diags)
-		return 1
-	}
-	targetMod := targetConfig.Module
-	rcs := targetMod.ManagedResources
-	var rc *configs.Resource
-	resourceRelAddr := addr.Resource.Resource
-	for _, thisRc := range rcs {
-		if resourceRelAddr.Type == thisRc.Type && resourceRelAddr.Name == thisRc.Name {
-			rc = thisRc
-			break
+	//
+	// When -from-file is set, each entry in the file is validated once the
+	// targets are built below, so we skip this early check here.
+	if parsedArgs.FromFile == "" {
+		if _, ok := c.resourceConfigForImport(diags, config, addr); !ok {
+			return 1
 		}
 	}
-	if rc == nil {
-		modulePath := addr.Module.String()
-		if modulePath == "" {
-			modulePath = "the root module"
-		}
-
-		c.showDiagnostics(diags)
-
-		// This is not a diagnostic because currently our diagnostics printer
-		// doesn't support having a code example in the detail, and there's
-		// a code example in this message.
-		// TODO: Improve the diagnostics printer so we can use it for this
-		// message.
-		c.Ui.Error(fmt.Sprintf(
-			importCommandMissingResourceFmt,
-			addr, modulePath, resourceRelAddr.Type, resourceRelAddr.Name,
-		))
-		return 1
-	}
 
 	// Check for user-supplied plugin path
 	if c.pluginPath, err = c.loadPluginPath(); err != nil {
@@ -223,16 +184,30 @@ func (c *ImportCommand) Run(args []string) int {
 		}
 	}()
 
-	// Perform the import. Note that as you can see it is possible for this
-	// API to import more than one resource at once. For now, we only allow
-	// one while we stabilize this feature.
-	newState, importDiags := lr.Core.Import(lr.Config, lr.InputState, &terraform.ImportOpts{
-		Targets: []*terraform.ImportTarget{
+	// Build the list of targets to import. Normally this is just the single
+	// ADDR/ID pair given on the command line, but -from-file allows many
+	// resources, each potentially using identity-based import, to be
+	// imported in one operation.
+	var targets []*terraform.ImportTarget
+	if parsedArgs.FromFile != "" {
+		var targetDiags tfdiags.Diagnostics
+		targets, targetDiags = c.importTargetsFromFile(parsedArgs.FromFile, config, lr.Core, lr.Config, lr.InputState)
+		diags = diags.Append(targetDiags)
+		if targetDiags.HasErrors() {
+			c.showDiagnostics(diags)
+			return 1
+		}
+	} else {
+		targets = []*terraform.ImportTarget{
 			{
 				LegacyAddr: addr,
 				LegacyID:   parsedArgs.ID,
 			},
-		},
+		}
+	}
+
+	newState, importDiags := lr.Core.Import(lr.Config, lr.InputState, &terraform.ImportOpts{
+		Targets: targets,
 
 		// The LocalRun idea is designed around our primary operations, so
 		// the input variables end up represented as plan options even though
@@ -245,6 +220,26 @@ func (c *ImportCommand) Run(args []string) int {
 		return 1
 	}
 
+	if parsedArgs.Preview {
+		schemas, schemaDiags := lr.Core.Schemas(lr.Config, newState)
+		diags = diags.Append(schemaDiags)
+		if schemaDiags.HasErrors() {
+			c.showDiagnostics(diags)
+			return 1
+		}
+
+		view := views.NewShow(arguments.ViewHuman, c.View, false, jsonformat.DeferredGranularityInstance, false)
+		view.Display(nil, nil, nil, statefile.New(newState, "", 0), schemas)
+
+		c.Ui.Output(c.Colorize().Color("[reset][green]\n" + importCommandPreviewMsg))
+
+		c.showDiagnostics(diags)
+		if diags.HasErrors() {
+			return 1
+		}
+		return 0
+	}
+
 	// Get schemas, if possible, before writing state
 	var schemas *terraform.Schemas
 	if isCloudMode(b) {
@@ -274,9 +269,194 @@ func (c *ImportCommand) Run(args []string) int {
 	return 0
 }
 
+// parseImportAddr parses and validates a resource instance address given on
+// the command line, as used for the single-resource form of this command.
+func (c *ImportCommand) parseImportAddr(raw string) (addrs.AbsResourceInstance, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	traversalSrc := []byte(raw)
+	traversal, travDiags := hclsyntax.ParseTraversalAbs(traversalSrc, "<import-address>", hcl.Pos{Line: 1, Column: 1})
+	diags = diags.Append(travDiags)
+	if travDiags.HasErrors() {
+		c.registerSynthConfigSource("<import-address>", traversalSrc) // so we can include a source snippet
+		c.Ui.Info(importCommandInvalidAddressReference)
+		return addrs.AbsResourceInstance{}, diags
+	}
+	addr, addrDiags := addrs.ParseAbsResourceInstance(traversal)
+	diags = diags.Append(addrDiags)
+	if addrDiags.HasErrors() {
+		c.registerSynthConfigSource("<import-address>", traversalSrc) // so we can include a source snippet
+		c.Ui.Info(importCommandInvalidAddressReference)
+		return addrs.AbsResourceInstance{}, diags
+	}
+
+	if addr.Resource.Resource.Mode != addrs.ManagedResourceMode {
+		diags = diags.Append(errors.New("A managed resource address is required. Importing into a data resource is not allowed."))
+		return addrs.AbsResourceInstance{}, diags
+	}
+
+	return addr, diags
+}
+
+// resourceConfigForImport looks up the configs.Resource that corresponds to
+// addr, reporting an error (using diags as the diagnostics accumulated so
+// far) and returning false if no such resource is configured.
+func (c *ImportCommand) resourceConfigForImport(diags tfdiags.Diagnostics, config *configs.Config, addr addrs.AbsResourceInstance) (*configs.Resource, bool) {
+	targetConfig := config.DescendantForInstance(addr.Module)
+	if targetConfig == nil {
+		modulePath := addr.Module.String()
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Import to non-existent module",
+			Detail: fmt.Sprintf(
+				"%s is not defined in the configuration. Please add configuration for this module before importing into it.",
+				modulePath,
+			),
+		})
+		c.showDiagnostics(diags)
+		return nil, false
+	}
+
+	resourceRelAddr := addr.Resource.Resource
+	for _, thisRc := range targetConfig.Module.ManagedResources {
+		if resourceRelAddr.Type == thisRc.Type && resourceRelAddr.Name == thisRc.Name {
+			return thisRc, true
+		}
+	}
+
+	modulePath := addr.Module.String()
+	if modulePath == "" {
+		modulePath = "the root module"
+	}
+
+	c.showDiagnostics(diags)
+
+	// This is not a diagnostic because currently our diagnostics printer
+	// doesn't support having a code example in the detail, and there's
+	// a code example in this message.
+	// TODO: Improve the diagnostics printer so we can use it for this
+	// message.
+	c.Ui.Error(fmt.Sprintf(
+		importCommandMissingResourceFmt,
+		addr, modulePath, resourceRelAddr.Type, resourceRelAddr.Name,
+	))
+	return nil, false
+}
+
+// importFileEntry describes one resource to import, as decoded from a JSON
+// document passed to -from-file. Its field names intentionally mirror the
+// attributes of the "import" configuration block.
+type importFileEntry struct {
+	To       string          `json:"to"`
+	ID       string          `json:"id,omitempty"`
+	Identity json.RawMessage `json:"identity,omitempty"`
+}
+
+// importTargetsFromFile reads a JSON file written in the -from-file format
+// and builds one *terraform.ImportTarget per entry. Entries that set
+// "identity" are resolved using the target resource type's identity schema,
+// fetched from ctx; entries that set "id" behave like the plain command-line
+// form of this command.
+func (c *ImportCommand) importTargetsFromFile(path string, config *configs.Config, ctx *terraform.Context, ctxConfig *configs.Config, state *states.State) ([]*terraform.ImportTarget, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read import file",
+			fmt.Sprintf("Could not read %q: %s.", path, err),
+		))
+		return nil, diags
+	}
+
+	var entries []importFileEntry
+	if err := json.Unmarshal(src, &entries); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid import file",
+			fmt.Sprintf("Could not parse %q as JSON: %s.", path, err),
+		))
+		return nil, diags
+	}
+
+	var targets []*terraform.ImportTarget
+	for _, entry := range entries {
+		traversalSrc := []byte(entry.To)
+		traversal, travDiags := hclsyntax.ParseTraversalAbs(traversalSrc, path, hcl.Pos{Line: 1, Column: 1})
+		diags = diags.Append(travDiags)
+		if travDiags.HasErrors() {
+			continue
+		}
+		addr, addrDiags := addrs.ParseAbsResourceInstance(traversal)
+		diags = diags.Append(addrDiags)
+		if addrDiags.HasErrors() {
+			continue
+		}
+		if addr.Resource.Resource.Mode != addrs.ManagedResourceMode {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid import target",
+				fmt.Sprintf("%s is a data resource; only managed resources can be imported.", addr),
+			))
+			continue
+		}
+
+		rc, ok := c.resourceConfigForImport(diags, config, addr)
+		if !ok {
+			continue
+		}
+
+		target := &terraform.ImportTarget{
+			LegacyAddr: addr,
+			LegacyID:   entry.ID,
+		}
+
+		if len(entry.Identity) > 0 {
+			schemas, schemaDiags := ctx.Schemas(ctxConfig, state)
+			diags = diags.Append(schemaDiags)
+			if schemaDiags.HasErrors() {
+				continue
+			}
+			schema := schemas.ResourceTypeConfig(rc.Provider, addrs.ManagedResourceMode, addr.Resource.Resource.Type)
+			if schema.Identity == nil {
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					"Invalid import target",
+					fmt.Sprintf("%s does not support importing by identity.", addr),
+				))
+				continue
+			}
+			identity, err := ctyjson.Unmarshal(entry.Identity, schema.Identity.ImpliedType())
+			if err != nil {
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					"Invalid import target",
+					fmt.Sprintf("Invalid identity for %s: %s.", addr, err),
+				))
+				continue
+			}
+			target.LegacyIdentity = identity
+		}
+
+		targets = append(targets, target)
+	}
+
+	if !diags.HasErrors() && len(targets) == 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid import file",
+			fmt.Sprintf("%q does not contain any import targets.", path),
+		))
+	}
+
+	return targets, diags
+}
+
 func (c *ImportCommand) Help() string {
 	helpText := `
 Usage: terraform [global options] import [options] ADDR ID
+       terraform [global options] import [options] -from-file=path
 
   Import existing infrastructure into your Terraform state.
 
@@ -291,6 +471,10 @@ Usage: terraform [global options] import [options] ADDR ID
   determine the ID syntax to use. It typically matches directly to the ID
   that the provider uses.
 
+  Alternatively, -from-file can be used to import many resources in a
+  single operation, some of which may use resource identity instead of an
+  ID. See the -from-file option below for details.
+
   This command will not modify your infrastructure, but it will make
   network requests to inspect parts of your infrastructure relevant to
   the resource being imported.
@@ -323,6 +507,15 @@ Options:
   -ignore-remote-version  A rare option used for the remote backend only. See
                           the remote backend documentation for more information.
 
+  -from-file=path         Import multiple resources at once from a JSON file,
+                          instead of the ADDR and ID arguments. The file must
+                          contain a JSON array of objects, each with a "to"
+                          address and either an "id" or a resource type
+                          specific "identity" object.
+
+  -preview                Show the state that importing would produce without
+                          writing it to the state file.
+
   -state, state-out, and -backup are legacy options supported for the local
   backend only. For more information, see the local backend's documentation.
 
@@ -351,3 +544,9 @@ const importCommandSuccessMsg = `Import successful!
 The resources that were imported are shown above. These resources are now in
 your Terraform state and will henceforth be managed by Terraform.
 `
+
+const importCommandPreviewMsg = `Import preview complete!
+
+The state shown above is what importing would produce. Your Terraform state
+has not been modified; re-run without -preview to perform the import.
+`
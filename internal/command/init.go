@@ -34,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform/internal/policy"
 	"github.com/hashicorp/terraform/internal/providercache"
 	"github.com/hashicorp/terraform/internal/states"
+	"github.com/hashicorp/terraform/internal/terraform"
 	"github.com/hashicorp/terraform/internal/tfdiags"
 	tfversion "github.com/hashicorp/terraform/version"
 )
@@ -674,6 +675,12 @@ func (c *InitCommand) getProviders(ctx context.Context, config *configs.Config,
 		return true, nil, diags
 	}
 
+	for provider, newLock := range newLocks.AllProviders() {
+		if oldLock := locks.Provider(provider); oldLock != nil {
+			diags = diags.Append(terraform.CheckProviderVersionDowngrade(provider, newLock.Version(), oldLock.Version()))
+		}
+	}
+
 	return true, newLocks, diags
 }
 
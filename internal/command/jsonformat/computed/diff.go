@@ -31,6 +31,12 @@ type Diff struct {
 	// Every single change could potentially add this suffix, so we embed it in
 	// the change as common functionality instead of in the specific renderers.
 	Replace bool
+
+	// Deferred tells an unknown value's renderer to print a "(deferred)"
+	// marker instead of the usual "(known after apply)" marker, so a reader
+	// can tell the difference between a value that is merely computed and
+	// one that couldn't be resolved at all during this plan.
+	Deferred bool
 }
 
 // NewDiff creates a new Diff object with the provided renderer, action and
@@ -440,6 +440,25 @@ jsonencode(
 			},
 			expected: "(known after apply)",
 		},
+		"computed_create_deferred": {
+			diff: computed.Diff{
+				Renderer: Unknown(computed.Diff{}),
+				Action:   plans.Create,
+				Deferred: true,
+			},
+			expected: "(deferred)",
+		},
+		"computed_update_deferred": {
+			diff: computed.Diff{
+				Renderer: Unknown(computed.Diff{
+					Renderer: Primitive(json.Number("0"), nil, cty.Number),
+					Action:   plans.Delete,
+				}),
+				Action:   plans.Update,
+				Deferred: true,
+			},
+			expected: "0 -> (deferred)",
+		},
 		"computed_create_forces_replacement": {
 			diff: computed.Diff{
 				Renderer: Unknown(computed.Diff{}),
@@ -31,8 +31,13 @@ func (renderer unknownRenderer) RenderHuman(diff computed.Diff, indent int, opts
 	// previously returned a null value for the computed attribute and is now
 	// declaring they will recompute it as part of the next update.
 
+	marker := "(known after apply)"
+	if diff.Deferred {
+		marker = "(deferred)"
+	}
+
 	if diff.Action == plans.Create || renderer.before.Renderer == nil {
-		return fmt.Sprintf("(known after apply)%s", forcesReplacement(diff.Replace, opts))
+		return fmt.Sprintf("%s%s", marker, forcesReplacement(diff.Replace, opts))
 	}
 
 	beforeOpts := opts.Clone()
@@ -43,5 +48,5 @@ func (renderer unknownRenderer) RenderHuman(diff computed.Diff, indent int, opts
 		// change, then do not display it for the before specifically.
 		beforeOpts.ForbidForcesReplacement = true
 	}
-	return fmt.Sprintf("%s -> (known after apply)%s", renderer.before.RenderHuman(indent, beforeOpts), forcesReplacement(diff.Replace, opts))
+	return fmt.Sprintf("%s -> %s%s", renderer.before.RenderHuman(indent, beforeOpts), marker, forcesReplacement(diff.Replace, opts))
 }
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package jsonformat
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DeferredGranularity selects how FormatDeferredResourceChangesHuman groups
+// the deferred resources it reports.
+type DeferredGranularity int
+
+const (
+	// DeferredGranularityInstance reports one line per deferred resource
+	// instance.
+	DeferredGranularityInstance DeferredGranularity = iota
+
+	// DeferredGranularityResource coalesces deferred instances that belong
+	// to the same resource (for example, different instances of the same
+	// resource with count or for_each) into a single line.
+	DeferredGranularityResource
+)
+
+// FormatDeferredResourceChangesHuman renders a short, human-readable summary
+// of the resources that were deferred in plan, giving each one's address
+// and the reason it was deferred. granularity controls whether each
+// resource instance is reported individually or coalesced per resource.
+//
+// This is the basis for `terraform show -deferred`, which prints just this
+// summary instead of the full plan diff, so that automation can quickly
+// check which resources still need a follow-up run to converge.
+func FormatDeferredResourceChangesHuman(plan Plan, granularity DeferredGranularity) string {
+	if len(plan.DeferredChanges) == 0 {
+		return "No deferred changes.\n"
+	}
+
+	if granularity == DeferredGranularityResource {
+		return formatDeferredResourceChangesHumanByResource(plan)
+	}
+
+	var buf strings.Builder
+	for _, dc := range plan.DeferredChanges {
+		fmt.Fprintf(&buf, "%s: deferred (%s)\n", dc.ResourceChange.Address, dc.Reason)
+	}
+	return buf.String()
+}
+
+// resourceAddress trims the instance key (if any) off of a resource
+// instance address, leaving the address of the resource it belongs to.
+func resourceAddress(instanceAddr string) string {
+	if idx := strings.IndexByte(instanceAddr, '['); idx >= 0 {
+		return instanceAddr[:idx]
+	}
+	return instanceAddr
+}
+
+func formatDeferredResourceChangesHumanByResource(plan Plan) string {
+	type group struct {
+		addr    string
+		count   int
+		reasons map[string]bool
+	}
+
+	groups := make(map[string]*group)
+	var order []string
+	for _, dc := range plan.DeferredChanges {
+		addr := resourceAddress(dc.ResourceChange.Address)
+		g, ok := groups[addr]
+		if !ok {
+			g = &group{addr: addr, reasons: make(map[string]bool)}
+			groups[addr] = g
+			order = append(order, addr)
+		}
+		g.count++
+		g.reasons[dc.Reason] = true
+	}
+	sort.Strings(order)
+
+	var buf strings.Builder
+	for _, addr := range order {
+		g := groups[addr]
+		reasons := make([]string, 0, len(g.reasons))
+		for r := range g.reasons {
+			reasons = append(reasons, r)
+		}
+		sort.Strings(reasons)
+
+		instances := "instance"
+		if g.count != 1 {
+			instances = "instances"
+		}
+		fmt.Fprintf(&buf, "%s: %d %s deferred (%s)\n", g.addr, g.count, instances, strings.Join(reasons, ", "))
+	}
+	return buf.String()
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package jsonformat
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/command/jsonplan"
+)
+
+func TestFormatDeferredResourceChangesHuman(t *testing.T) {
+	t.Run("no deferred changes", func(t *testing.T) {
+		got := FormatDeferredResourceChangesHuman(Plan{}, DeferredGranularityInstance)
+		want := "No deferred changes.\n"
+		if got != want {
+			t.Errorf("wrong result\ngot:  %q\nwant: %q", got, want)
+		}
+	})
+
+	plan := Plan{
+		DeferredChanges: []jsonplan.DeferredResourceChange{
+			{
+				Reason: "provider_config_unknown",
+				ResourceChange: jsonplan.ResourceChange{
+					Address: "test_instance.example",
+				},
+			},
+			{
+				Reason: "instance_count_unknown",
+				ResourceChange: jsonplan.ResourceChange{
+					Address: "test_instance.other[0]",
+				},
+			},
+			{
+				Reason: "instance_count_unknown",
+				ResourceChange: jsonplan.ResourceChange{
+					Address: "test_instance.other[1]",
+				},
+			},
+		},
+	}
+
+	t.Run("per-instance granularity", func(t *testing.T) {
+		got := FormatDeferredResourceChangesHuman(plan, DeferredGranularityInstance)
+		want := "test_instance.example: deferred (provider_config_unknown)\n" +
+			"test_instance.other[0]: deferred (instance_count_unknown)\n" +
+			"test_instance.other[1]: deferred (instance_count_unknown)\n"
+		if got != want {
+			t.Errorf("wrong result\ngot:  %q\nwant: %q", got, want)
+		}
+	})
+
+	t.Run("per-resource granularity", func(t *testing.T) {
+		got := FormatDeferredResourceChangesHuman(plan, DeferredGranularityResource)
+		want := "test_instance.example: 1 instance deferred (provider_config_unknown)\n" +
+			"test_instance.other: 2 instances deferred (instance_count_unknown)\n"
+		if got != want {
+			t.Errorf("wrong result\ngot:  %q\nwant: %q", got, want)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package jsonformat
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform/internal/plans"
+)
+
+// OutputPlugin is an extension point over the plan view, letting operators
+// swap in a bespoke rendering of a structured plan (including any deferred
+// changes) in place of Terraform's own human-readable format.
+//
+// Plugins are registered ahead of time with RegisterOutputPlugin and then
+// selected by name at the command line with the -output-plugin flag.
+type OutputPlugin interface {
+	// RenderPlan returns the rendering of plan that should be printed in
+	// place of Terraform's built-in human-readable plan output.
+	RenderPlan(plan Plan, mode plans.Mode, opts ...plans.Quality) string
+}
+
+// OutputPluginFunc adapts a plain function to the OutputPlugin interface.
+type OutputPluginFunc func(plan Plan, mode plans.Mode, opts ...plans.Quality) string
+
+func (f OutputPluginFunc) RenderPlan(plan Plan, mode plans.Mode, opts ...plans.Quality) string {
+	return f(plan, mode, opts...)
+}
+
+var (
+	outputPluginsMu sync.RWMutex
+	outputPlugins   = map[string]OutputPlugin{}
+)
+
+// RegisterOutputPlugin makes an OutputPlugin available under the given name
+// for selection via the -output-plugin flag.
+//
+// It's expected to be called from init functions of packages that implement
+// custom plan output plugins; registering the same name twice is a bug in
+// the caller and will panic.
+func RegisterOutputPlugin(name string, plugin OutputPlugin) {
+	outputPluginsMu.Lock()
+	defer outputPluginsMu.Unlock()
+
+	if _, exists := outputPlugins[name]; exists {
+		panic(fmt.Sprintf("output plugin %q already registered", name))
+	}
+	outputPlugins[name] = plugin
+}
+
+// LookupOutputPlugin returns the OutputPlugin registered under name, if any.
+func LookupOutputPlugin(name string) (OutputPlugin, bool) {
+	outputPluginsMu.RLock()
+	defer outputPluginsMu.RUnlock()
+
+	plugin, ok := outputPlugins[name]
+	return plugin, ok
+}
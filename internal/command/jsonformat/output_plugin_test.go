@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package jsonformat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mitchellh/colorstring"
+
+	"github.com/hashicorp/terraform/internal/plans"
+	"github.com/hashicorp/terraform/internal/terminal"
+)
+
+func TestRenderHumanPlan_OutputPlugin(t *testing.T) {
+	color := &colorstring.Colorize{Colors: colorstring.DefaultColors, Disable: true}
+	streams, done := terminal.StreamsForTesting(t)
+
+	plugin := OutputPluginFunc(func(plan Plan, mode plans.Mode, opts ...plans.Quality) string {
+		return fmt.Sprintf("fake-output-plugin: mode=%s", mode)
+	})
+
+	renderer := Renderer{Colorize: color, Streams: streams, OutputPlugin: plugin}
+	renderer.RenderHumanPlan(Plan{}, plans.NormalMode)
+
+	want := "fake-output-plugin: mode=NormalMode\n"
+	got := done(t).Stdout()
+	if got != want {
+		t.Errorf("unexpected output\ngot:  %q\nwant: %q", got, want)
+	}
+}
+
+func TestRegisterAndLookupOutputPlugin(t *testing.T) {
+	name := "test-output-plugin"
+	plugin := OutputPluginFunc(func(plan Plan, mode plans.Mode, opts ...plans.Quality) string {
+		return "rendered by test-output-plugin"
+	})
+
+	RegisterOutputPlugin(name, plugin)
+
+	got, ok := LookupOutputPlugin(name)
+	if !ok {
+		t.Fatalf("expected %q to be registered", name)
+	}
+	if got.RenderPlan(Plan{}, plans.NormalMode) != "rendered by test-output-plugin" {
+		t.Fatalf("looked up plugin did not behave as expected")
+	}
+
+	if _, ok := LookupOutputPlugin("does-not-exist"); ok {
+		t.Fatalf("expected unregistered plugin name to not be found")
+	}
+}
@@ -124,6 +124,12 @@ type Renderer struct {
 	Colorize *colorstring.Colorize
 
 	RunningInAutomation bool
+
+	// OutputPlugin, when set, takes over rendering of RenderHumanPlan
+	// entirely, allowing an operator to substitute their own plan
+	// presentation (selected with the -output-plugin flag) in place of
+	// Terraform's built-in human-readable format.
+	OutputPlugin OutputPlugin
 }
 
 func (renderer Renderer) RenderHumanPlan(plan Plan, mode plans.Mode, opts ...plans.Quality) {
@@ -133,6 +139,11 @@ func (renderer Renderer) RenderHumanPlan(plan Plan, mode plans.Mode, opts ...pla
 			renderer.Streams.Stdout.Columns()))
 	}
 
+	if renderer.OutputPlugin != nil {
+		renderer.Streams.Println(renderer.OutputPlugin.RenderPlan(plan, mode, opts...))
+		return
+	}
+
 	plan.renderHuman(renderer, mode, opts...)
 }
 
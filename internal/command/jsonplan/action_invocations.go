@@ -196,9 +196,17 @@ func MarshalActionInvocation(action *plans.ActionInvocationInstanceSrc, schemas
 // DeferredActionInvocation is a description of an action invocation that has been
 // deferred for some reason.
 type DeferredActionInvocation struct {
-	// Reason is the reason why this action was deferred.
+	// Reason is the reason why this action was deferred. It's one of a
+	// fixed set of well-known values, or "unknown" if the provider gave a
+	// deferral reason this version of Terraform doesn't recognize.
 	Reason string `json:"reason"`
 
+	// CustomReason carries the provider-supplied deferral reason verbatim
+	// when Reason is "unknown" because the provider used a domain-specific
+	// reason outside the well-known set. It's empty whenever Reason is one
+	// of the well-known values.
+	CustomReason string `json:"custom_reason,omitempty"`
+
 	// Change contains any information we have about the deferred change.
 	ActionInvocation ActionInvocation `json:"action_invocation"`
 }
@@ -235,8 +243,11 @@ func MarshalDeferredActionInvocations(dais []*plans.DeferredActionInvocationSrc,
 			// If we find a reason we don't know about, we'll just mark it as
 			// unknown. This is a bit of a safety net to ensure that we don't
 			// break if new reasons are introduced in future versions of the
-			// provider protocol.
+			// provider protocol. We still carry the provider's own reason
+			// through as CustomReason so it isn't lost to the consumer of
+			// this output.
 			dai.Reason = DeferredReasonUnknown
+			dai.CustomReason = string(daiSrc.DeferredReason)
 		}
 
 		deferredInvocations = append(deferredInvocations, dai)
@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package jsonplan
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/plans"
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/terraform"
+)
+
+func deferredResourceChangeSrc(t *testing.T, reason providers.DeferredReason) *plans.DeferredResourceInstanceChangeSrc {
+	t.Helper()
+
+	addr := mustAddr("test_thing.example")
+	after, err := plans.NewDynamicValue(cty.ObjectVal(map[string]cty.Value{
+		"woozles": cty.StringVal("foo"),
+		"foozles": cty.NullVal(cty.String),
+	}), cty.Object(map[string]cty.Type{
+		"woozles": cty.String,
+		"foozles": cty.String,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return &plans.DeferredResourceInstanceChangeSrc{
+		DeferredReason: reason,
+		ChangeSrc: &plans.ResourceInstanceChangeSrc{
+			Addr:        addr,
+			PrevRunAddr: addr,
+			ProviderAddr: addrs.AbsProviderConfig{
+				Provider: addrs.NewDefaultProvider("test"),
+				Module:   addrs.RootModule,
+			},
+			ChangeSrc: plans.ChangeSrc{
+				Action: plans.Create,
+				After:  after,
+			},
+		},
+	}
+}
+
+func TestMarshalDeferredResourceChanges_customReason(t *testing.T) {
+	changes, err := MarshalDeferredResourceChanges(
+		[]*plans.DeferredResourceInstanceChangeSrc{
+			deferredResourceChangeSrc(t, providers.DeferredReason("vendor_quota_exceeded")),
+		},
+		testSchemas(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(changes) != 1 {
+		t.Fatalf("expected 1 change, got %d", len(changes))
+	}
+
+	got := changes[0]
+	if got.Reason != DeferredReasonUnknown {
+		t.Fatalf("expected Reason to be %q, got %q", DeferredReasonUnknown, got.Reason)
+	}
+	if got.CustomReason != "vendor_quota_exceeded" {
+		t.Fatalf("expected CustomReason to round-trip, got %q", got.CustomReason)
+	}
+}
+
+func deferredActionInvocationSrc(t *testing.T, reason providers.DeferredReason) *plans.DeferredActionInvocationSrc {
+	t.Helper()
+
+	return &plans.DeferredActionInvocationSrc{
+		DeferredReason: reason,
+		ActionInvocationInstanceSrc: &plans.ActionInvocationInstanceSrc{
+			Addr: addrs.AbsActionInstance{
+				Module: addrs.RootModuleInstance,
+				Action: addrs.ActionInstance{
+					Action: addrs.Action{
+						Type: "test_action",
+						Name: "test",
+					},
+					Key: addrs.NoKey,
+				},
+			},
+			ActionTrigger: new(plans.InvokeActionTrigger),
+			ConfigValue:   mustDynamicValue(t, cty.ObjectVal(map[string]cty.Value{})),
+			ProviderAddr: addrs.AbsProviderConfig{
+				Provider: addrs.NewDefaultProvider("test"),
+				Module:   addrs.RootModule,
+			},
+		},
+	}
+}
+
+func actionSchemas() *terraform.Schemas {
+	return &terraform.Schemas{
+		Providers: map[addrs.Provider]providers.ProviderSchema{
+			addrs.NewDefaultProvider("test"): {
+				Actions: map[string]providers.ActionSchema{
+					"test_action": {
+						ConfigSchema: &configschema.Block{},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestMarshalDeferredActionInvocations_customReason(t *testing.T) {
+	invocations, err := MarshalDeferredActionInvocations(
+		[]*plans.DeferredActionInvocationSrc{
+			deferredActionInvocationSrc(t, providers.DeferredReason("vendor_quota_exceeded")),
+		},
+		actionSchemas(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(invocations) != 1 {
+		t.Fatalf("expected 1 invocation, got %d", len(invocations))
+	}
+
+	got := invocations[0]
+	if got.Reason != DeferredReasonUnknown {
+		t.Fatalf("expected Reason to be %q, got %q", DeferredReasonUnknown, got.Reason)
+	}
+	if got.CustomReason != "vendor_quota_exceeded" {
+		t.Fatalf("expected CustomReason to round-trip, got %q", got.CustomReason)
+	}
+}
+
+func TestMarshalDeferredActionInvocations_wellKnownReason(t *testing.T) {
+	invocations, err := MarshalDeferredActionInvocations(
+		[]*plans.DeferredActionInvocationSrc{
+			deferredActionInvocationSrc(t, providers.DeferredReasonProviderConfigUnknown),
+		},
+		actionSchemas(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := invocations[0]
+	if got.Reason != DeferredReasonProviderConfigUnknown {
+		t.Fatalf("expected Reason to be %q, got %q", DeferredReasonProviderConfigUnknown, got.Reason)
+	}
+	if got.CustomReason != "" {
+		t.Fatalf("expected CustomReason to be empty for a well-known reason, got %q", got.CustomReason)
+	}
+}
+
+func TestMarshalDeferredResourceChanges_multipleDistinctReasons(t *testing.T) {
+	changes, err := MarshalDeferredResourceChanges(
+		[]*plans.DeferredResourceInstanceChangeSrc{
+			deferredResourceChangeSrc(t, providers.DeferredReasonProviderConfigUnknown),
+			deferredResourceChangeSrc(t, providers.DeferredReasonInstanceCountUnknown),
+		},
+		testSchemas(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %d", len(changes))
+	}
+
+	gotReasons := map[string]bool{
+		changes[0].Reason: true,
+		changes[1].Reason: true,
+	}
+	wantReasons := map[string]bool{
+		DeferredReasonProviderConfigUnknown: true,
+		DeferredReasonInstanceCountUnknown:  true,
+	}
+	if !reflect.DeepEqual(gotReasons, wantReasons) {
+		t.Fatalf("expected to see both reasons %v, got %v", wantReasons, gotReasons)
+	}
+}
+
+func TestMarshalDeferredResourceChanges_wellKnownReason(t *testing.T) {
+	changes, err := MarshalDeferredResourceChanges(
+		[]*plans.DeferredResourceInstanceChangeSrc{
+			deferredResourceChangeSrc(t, providers.DeferredReasonProviderConfigUnknown),
+		},
+		testSchemas(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := changes[0]
+	if got.Reason != DeferredReasonProviderConfigUnknown {
+		t.Fatalf("expected Reason to be %q, got %q", DeferredReasonProviderConfigUnknown, got.Reason)
+	}
+	if got.CustomReason != "" {
+		t.Fatalf("expected CustomReason to be empty for a well-known reason, got %q", got.CustomReason)
+	}
+}
@@ -692,8 +692,11 @@ func MarshalDeferredResourceChanges(resources []*plans.DeferredResourceInstanceC
 			// If we find a reason we don't know about, we'll just mark it as
 			// unknown. This is a bit of a safety net to ensure that we don't
 			// break if new reasons are introduced in future versions of the
-			// provider protocol.
+			// provider protocol. We still carry the provider's own reason
+			// through as CustomReason so it isn't lost to the consumer of
+			// this output.
 			deferredChange.Reason = DeferredReasonUnknown
+			deferredChange.CustomReason = string(rc.DeferredReason)
 		}
 
 		ret = append(ret, deferredChange)
@@ -108,9 +108,17 @@ type ResourceChange struct {
 // DeferredResourceChange is a description of a resource change that has been
 // deferred for some reason.
 type DeferredResourceChange struct {
-	// Reason is the reason why this resource change was deferred.
+	// Reason is the reason why this resource change was deferred. It's one
+	// of a fixed set of well-known values, or "unknown" if the provider gave
+	// a deferral reason this version of Terraform doesn't recognize.
 	Reason string `json:"reason"`
 
+	// CustomReason carries the provider-supplied deferral reason verbatim
+	// when Reason is "unknown" because the provider used a domain-specific
+	// reason outside the well-known set. It's empty whenever Reason is one
+	// of the well-known values.
+	CustomReason string `json:"custom_reason,omitempty"`
+
 	// Change contains any information we have about the deferred change.
 	ResourceChange ResourceChange `json:"resource_change"`
 }
@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package jsonprovider
+
+import (
+	"sort"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/terraform"
+)
+
+// CompletionFormatVersion represents the version of the completion export
+// format and will be incremented for any change to this format that
+// requires changes to a consuming editor or IDE integration.
+const CompletionFormatVersion = "1.0"
+
+// Completion is the top-level object returned when exporting provider
+// schemas in a format intended for configuration autocompletion tooling.
+// Unlike Providers, it flattens each schema down to the information an
+// editor needs to offer completions: attribute names, types, descriptions,
+// and nesting, without the rest of the schema representation.
+type Completion struct {
+	FormatVersion string                         `json:"format_version"`
+	Schemas       map[string]*CompletionProvider `json:"provider_schemas,omitempty"`
+}
+
+// CompletionProvider groups the completion items for everything a single
+// provider contributes to configuration.
+type CompletionProvider struct {
+	Provider          *CompletionSchema            `json:"provider,omitempty"`
+	ResourceSchemas   map[string]*CompletionSchema `json:"resource_schemas,omitempty"`
+	DataSourceSchemas map[string]*CompletionSchema `json:"data_source_schemas,omitempty"`
+}
+
+// CompletionSchema is the set of completion items for a single
+// configuration block, such as a resource, data source, or provider
+// configuration.
+type CompletionSchema struct {
+	Attributes []*CompletionItem `json:"attributes,omitempty"`
+}
+
+// CompletionItem describes a single attribute or nested block in a form
+// convenient for an editor to offer as a completion suggestion: its name,
+// a friendly type label, its description, and any attributes nested
+// beneath it.
+type CompletionItem struct {
+	Name        string            `json:"name"`
+	Type        string            `json:"type,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Required    bool              `json:"required,omitempty"`
+	Optional    bool              `json:"optional,omitempty"`
+	Computed    bool              `json:"computed,omitempty"`
+	Deprecated  bool              `json:"deprecated,omitempty"`
+	Nested      []*CompletionItem `json:"nested,omitempty"`
+}
+
+// MarshalForCompletion converts the given schemas into the completion
+// export format. It uses the same provider, resource, and data source
+// schemas that MarshalForRenderer and Marshal use, so it reflects whatever
+// provider implementation produced them, including a stacks "unknown
+// provider" stand-in used during partial planning.
+func MarshalForCompletion(s *terraform.Schemas) *Completion {
+	schemas := make(map[string]*CompletionProvider, len(s.Providers))
+	for k, v := range s.Providers {
+		schemas[k.String()] = completionProvider(v)
+	}
+	return &Completion{
+		FormatVersion: CompletionFormatVersion,
+		Schemas:       schemas,
+	}
+}
+
+func completionProvider(tps providers.ProviderSchema) *CompletionProvider {
+	return &CompletionProvider{
+		Provider:          completionSchemaFromBlock(tps.Provider.Body),
+		ResourceSchemas:   completionSchemas(tps.ResourceTypes),
+		DataSourceSchemas: completionSchemas(tps.DataSources),
+	}
+}
+
+func completionSchemas(schemas map[string]providers.Schema) map[string]*CompletionSchema {
+	if len(schemas) == 0 {
+		return nil
+	}
+	ret := make(map[string]*CompletionSchema, len(schemas))
+	for k, v := range schemas {
+		ret[k] = completionSchemaFromBlock(v.Body)
+	}
+	return ret
+}
+
+func completionSchemaFromBlock(block *configschema.Block) *CompletionSchema {
+	if block == nil {
+		return &CompletionSchema{}
+	}
+	return &CompletionSchema{Attributes: completionItemsFromBlock(block)}
+}
+
+func completionItemsFromBlock(block *configschema.Block) []*CompletionItem {
+	items := make([]*CompletionItem, 0, len(block.Attributes)+len(block.BlockTypes))
+
+	for name, attr := range block.Attributes {
+		items = append(items, completionItemFromAttribute(name, attr))
+	}
+	for name, blockType := range block.BlockTypes {
+		items = append(items, completionItemFromBlockType(name, blockType))
+	}
+
+	sort.Slice(items, func(i, j int) bool { return items[i].Name < items[j].Name })
+	return items
+}
+
+func completionItemFromAttribute(name string, attr *configschema.Attribute) *CompletionItem {
+	item := &CompletionItem{
+		Name:        name,
+		Description: attr.Description,
+		Required:    attr.Required,
+		Optional:    attr.Optional,
+		Computed:    attr.Computed,
+		Deprecated:  attr.Deprecated,
+	}
+
+	if attr.NestedType != nil {
+		nested := make([]*CompletionItem, 0, len(attr.NestedType.Attributes))
+		for nestedName, nestedAttr := range attr.NestedType.Attributes {
+			nested = append(nested, completionItemFromAttribute(nestedName, nestedAttr))
+		}
+		sort.Slice(nested, func(i, j int) bool { return nested[i].Name < nested[j].Name })
+		item.Type = nestingModeString(attr.NestedType.Nesting)
+		item.Nested = nested
+	} else if attr.Type != cty.NilType {
+		item.Type = attr.Type.FriendlyName()
+	}
+
+	return item
+}
+
+func completionItemFromBlockType(name string, blockType *configschema.NestedBlock) *CompletionItem {
+	item := &CompletionItem{
+		Name:        name,
+		Type:        nestingModeString(blockType.Nesting),
+		Description: blockType.Description,
+		Deprecated:  blockType.Deprecated,
+	}
+
+	if len(blockType.Attributes) > 0 || len(blockType.BlockTypes) > 0 {
+		item.Nested = completionItemsFromBlock(&blockType.Block)
+	}
+
+	return item
+}
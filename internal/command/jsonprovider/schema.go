@@ -10,6 +10,11 @@ import (
 type Schema struct {
 	Version uint64 `json:"version"`
 	Block   *Block `json:"block,omitempty"`
+
+	// ImportIDExamples lists example import ID strings declared by the
+	// provider for this resource type, if any, to help operators discover
+	// the correct "terraform import" syntax.
+	ImportIDExamples []string `json:"import_id_examples,omitempty"`
 }
 
 // marshalSchema is a convenience wrapper around mashalBlock. Schema version
@@ -22,6 +27,7 @@ func marshalSchema(schema providers.Schema) *Schema {
 	var ret Schema
 	ret.Block = marshalBlock(schema.Body)
 	ret.Version = uint64(schema.Version)
+	ret.ImportIDExamples = schema.ImportIDExamples
 
 	return &ret
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/hashicorp/terraform/internal/configs/configschema"
 	"github.com/hashicorp/terraform/internal/providers"
 )
 
@@ -20,6 +21,26 @@ func TestMarshalSchemas(t *testing.T) {
 			nil,
 			map[string]*Schema{},
 		},
+		{
+			map[string]providers.Schema{
+				"test_foo": {
+					Body:             &configschema.Block{},
+					ImportIDExamples: []string{"foo-12345"},
+				},
+				"test_bar": {
+					Body: &configschema.Block{},
+				},
+			},
+			map[string]*Schema{
+				"test_foo": {
+					Block:            marshalBlock(&configschema.Block{}),
+					ImportIDExamples: []string{"foo-12345"},
+				},
+				"test_bar": {
+					Block: marshalBlock(&configschema.Block{}),
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -39,6 +60,16 @@ func TestMarshalSchema(t *testing.T) {
 			providers.Schema{},
 			&Schema{},
 		},
+		"import_id_examples": {
+			providers.Schema{
+				Body:             &configschema.Block{},
+				ImportIDExamples: []string{"12345", "arn:aws:example:foo"},
+			},
+			&Schema{
+				Block:            marshalBlock(&configschema.Block{}),
+				ImportIDExamples: []string{"12345", "arn:aws:example:foo"},
+			},
+		},
 	}
 
 	for _, test := range tests {
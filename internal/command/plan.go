@@ -36,7 +36,7 @@ func (c *PlanCommand) Run(rawArgs []string) int {
 
 	// Instantiate the view, even if there are flag errors, so that we render
 	// diagnostics according to the desired view
-	view := views.NewPlan(args.ViewType, c.View)
+	view := views.NewPlan(args.ViewType, c.View, args.OutputPlugin)
 
 	if diags.HasErrors() {
 		view.Diagnostics(diags)
@@ -166,6 +166,7 @@ func (c *PlanCommand) OperationRequest(be backendrun.OperationsBackend, view vie
 	opReq.GenerateConfigOut = generateConfigOut
 	opReq.Targets = args.Targets
 	opReq.ForceReplace = args.ForceReplace
+	opReq.ResourceCreateBudget = args.ResourceCreateBudget
 	opReq.Type = backendrun.OperationTypePlan
 	opReq.View = view.Operation()
 	opReq.ActionTargets = args.ActionTargets
@@ -281,6 +282,10 @@ Other Options:
   -parallelism=n             Limit the number of concurrent operations. Defaults
                              to 10.
 
+  -resource-create-budget=n  Error if the plan would create more than n
+                             resource instances. Defaults to 0, meaning no
+                             limit.
+
   -state=statefile           A legacy option used for the local backend only.
                              See the local backend's documentation for more
                              information.
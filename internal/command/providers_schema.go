@@ -5,6 +5,7 @@ package command
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform/internal/backend/backendrun"
@@ -101,6 +102,16 @@ func (c *ProvidersSchemaCommand) Run(args []string) int {
 		return 1
 	}
 
+	if parsedArgs.Completion {
+		completion, err := json.Marshal(jsonprovider.MarshalForCompletion(schemas))
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to marshal provider schemas to json: %s", err))
+			return 1
+		}
+		c.Ui.Output(string(completion))
+		return 0
+	}
+
 	jsonSchemas, err := jsonprovider.Marshal(schemas)
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf("Failed to marshal provider schemas to json: %s", err))
@@ -119,6 +130,11 @@ Usage: terraform [global options] providers schema -json
 
 Options:
 
+  -completion         Produce a reduced JSON representation of the schemas,
+                      with attribute names, types, descriptions, and nesting
+                      flattened into a form intended for configuration
+                      autocompletion tooling, rather than the full schema.
+
   -var 'foo=bar'      Set a value for one of the input variables in the root
                       module of the configuration. Use this option more than
                       once to set more than one variable.
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform/internal/backend"
 	backendInit "github.com/hashicorp/terraform/internal/backend/init"
 	backendCloud "github.com/hashicorp/terraform/internal/cloud"
+	"github.com/hashicorp/terraform/internal/command/jsonprovider"
 	"github.com/hashicorp/terraform/internal/command/workdir"
 
 	"github.com/hashicorp/terraform/internal/configs/configschema"
@@ -163,6 +164,69 @@ func TestProvidersSchema_output_withOverriddenWorkingDir(t *testing.T) {
 	}
 }
 
+func TestProvidersSchema_completion(t *testing.T) {
+	fixtureDir := "providers-schema/basic"
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath(fixtureDir), td)
+	t.Chdir(td)
+
+	providerSource := newMockProviderSource(t, map[string][]string{
+		"test": {"1.2.3"},
+	})
+
+	p := providersSchemaFixtureProvider()
+	ui := testUiWrapped(t)
+	view, done := testView(t)
+	m := Meta{
+		testingOverrides: metaOverridesForProvider(p),
+		Ui:               ui,
+		View:             view,
+		ProviderSource:   providerSource,
+	}
+
+	ic := &InitCommand{Meta: m}
+	if code := ic.Run([]string{}); code != 0 {
+		t.Fatalf("init failed\n%s", done(t).Stderr())
+	}
+
+	pc := &ProvidersSchemaCommand{Meta: m}
+	if code := pc.Run([]string{"-completion"}); code != 0 {
+		t.Fatalf("wrong exit status %d; want 0\nstderr: %s", code, ui.ErrorWriter.String())
+	}
+
+	var got jsonprovider.Completion
+	if err := json.Unmarshal([]byte(ui.OutputWriter.String()), &got); err != nil {
+		t.Fatalf("failed to unmarshal completion output: %s", err)
+	}
+
+	if got.FormatVersion != jsonprovider.CompletionFormatVersion {
+		t.Fatalf("wrong format version: got %q, want %q", got.FormatVersion, jsonprovider.CompletionFormatVersion)
+	}
+
+	provider, ok := got.Schemas["registry.terraform.io/hashicorp/test"]
+	if !ok {
+		t.Fatalf("missing completion data for provider \"test\"; got: %#v", got.Schemas)
+	}
+
+	resource, ok := provider.ResourceSchemas["test_instance"]
+	if !ok {
+		t.Fatalf("missing completion data for resource \"test_instance\"")
+	}
+
+	var foundVolumes bool
+	for _, attr := range resource.Attributes {
+		if attr.Name == "volumes" {
+			foundVolumes = true
+			if len(attr.Nested) != 2 {
+				t.Fatalf("expected 2 nested attributes under \"volumes\", got %d", len(attr.Nested))
+			}
+		}
+	}
+	if !foundVolumes {
+		t.Fatalf("expected completion data to include the \"volumes\" attribute, got: %#v", resource.Attributes)
+	}
+}
+
 func TestProvidersSchema_output_withStateStore(t *testing.T) {
 	// State with a 'baz' provider not in the config
 	originalState := states.BuildState(func(s *states.SyncState) {
@@ -52,6 +52,10 @@ Other Options:
                              part of the JSON output instead of written to a
                              file.
 
+  -out-file=path             Streams each list block's results to the file at
+                             PATH as they arrive, one JSON object per line,
+                             in addition to the normal output.
+
   -json                      If specified, machine readable output will be
                              printed in JSON format
 
@@ -126,6 +130,17 @@ func (c *QueryCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
+	if args.OutFile != "" {
+		fileHook, err := views.NewQueryFileHook(args.OutFile)
+		if err != nil {
+			diags = diags.Append(err)
+			view.Diagnostics(diags)
+			return 1
+		}
+		defer fileHook.Close()
+		opReq.Hooks = append(opReq.Hooks, fileHook)
+	}
+
 	if len(args.PolicyPaths) > 0 {
 		client, policyDiags, stopClient := c.PolicyClient(c.CommandContext(), args.PolicyPaths, backendPolicyEntitlement(be))
 		// if there has been any errors when setting up the policy client, we log them but
@@ -102,6 +102,16 @@ Blocks of type "resource" are not expected here.
 list.test_instance.example   id=test-instance-2   Test Instance 2
 
 Warning: list block(s) [list.test_instance.example2] returned 0 results.`,
+		},
+		{
+			name:      "query across multiple providers",
+			directory: "multi-provider",
+			expectedOut: `list.test_instance.from_test   id=test-instance-1   Test Instance 1
+list.test_instance.from_test   id=test-instance-2   Test Instance 2
+list.test_instance.from_test2   id=test-instance-1   Test Instance 1
+list.test_instance.from_test2   id=test-instance-2   Test Instance 2
+
+`,
 		},
 		{
 			name:      "error - extra variables",
@@ -186,7 +196,8 @@ this variable.
 			testCopyDir(t, testFixturePath(path.Join("query", ts.directory)), td)
 			t.Chdir(td)
 			providerSource := newMockProviderSource(t, map[string][]string{
-				"hashicorp/test": {"1.0.0"},
+				"hashicorp/test":  {"1.0.0"},
+				"hashicorp2/test": {"1.0.0"},
 			})
 
 			p := queryFixtureProvider()
@@ -252,6 +263,68 @@ this variable.
 	}
 }
 
+// TestQuery_outFile asserts that -out-file streams each list block's
+// results to the given file as they arrive, rather than only printing them
+// to the terminal.
+func TestQuery_outFile(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath(path.Join("query", "basic")), td)
+	t.Chdir(td)
+	providerSource := newMockProviderSource(t, map[string][]string{
+		"hashicorp/test": {"1.0.0"},
+	})
+
+	p := queryFixtureProvider()
+	view, done := testView(t)
+	meta := Meta{
+		testingOverrides:          metaOverridesForProvider(p),
+		View:                      view,
+		AllowExperimentalFeatures: true,
+		ProviderSource:            providerSource,
+	}
+
+	init := &InitCommand{Meta: meta}
+	if code := init.Run(nil); code != 0 {
+		t.Fatalf("init failed with status %d: %s", code, done(t).All())
+	}
+
+	view, done = testView(t)
+	meta.View = view
+
+	outFile := filepath.Join(td, "results.jsonl")
+	c := &QueryCommand{Meta: meta}
+	code := c.Run([]string{"-no-color", "-out-file=" + outFile})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, output.Stderr())
+	}
+
+	contents, err := os.ReadFile(outFile)
+	if err != nil {
+		t.Fatalf("failed to read output file: %s", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 streamed results, got %d: %s", len(lines), contents)
+	}
+
+	var first struct {
+		Address     string `json:"address"`
+		Identity    string `json:"identity"`
+		DisplayName string `json:"display_name"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatalf("failed to parse streamed result: %s", err)
+	}
+	if got, want := first.Address, "list.test_instance.example"; got != want {
+		t.Errorf("wrong address\ngot:  %s\nwant: %s", got, want)
+	}
+	if got, want := first.DisplayName, "Test Instance 1"; got != want {
+		t.Errorf("wrong display name\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
 // TestQuery_varFileDuplicateAttr is a regression test for a bug where a
 // -var-file containing a duplicated attribute would print an error diagnostic
 // but still exit 0, silently discarding the file and falling back to other
@@ -66,7 +66,7 @@ func (c *ShowCommand) Run(rawArgs []string) int {
 	c.viewType = args.ViewType
 
 	// Set up view
-	view := views.NewShow(args.ViewType, c.View)
+	view := views.NewShow(args.ViewType, c.View, args.Deferred, args.DeferredGranularity, args.Normalized)
 
 	loader, err := c.initConfigLoader()
 	if err != nil {
@@ -116,6 +116,20 @@ Options:
   -no-color           If specified, output won't contain any color.
   -json               If specified, output the Terraform plan or state in
                       a machine-readable form.
+  -deferred           If specified, output only a summary of the plan's
+                      deferred resources and their deferral reasons,
+                      instead of the full plan. Only meaningful when
+                      showing a plan file.
+  -deferred-granularity=instance
+                      Controls how -deferred summarizes deferred
+                      resources in human output: "instance" (the
+                      default) reports one line per deferred resource
+                      instance, and "resource" coalesces instances of
+                      the same resource into one line.
+  -normalized         If specified alongside -json, output a small,
+                      version-stable summary of the plan's changes instead
+                      of the full JSON plan representation. Only meaningful
+                      when showing a plan file.
 
 `
 	return strings.TrimSpace(helpText)
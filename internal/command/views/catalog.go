@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package views
+
+// Catalog holds the fixed message templates that OperationHuman prints
+// around an operation: interrupt notices, cancellation notices, and the
+// "next steps" hints printed after a plan. It does not cover resource
+// change summaries or diagnostic bodies, since those are generated from
+// provider and configuration content and are not safe to translate.
+//
+// The zero value is not useful; callers building a localized catalog
+// should start from DefaultCatalog and override only the fields they
+// have translations for.
+type Catalog struct {
+	Interrupted         string
+	FatalInterrupt      string
+	DestroyCancelled    string
+	ApplyCancelled      string
+	PlanHeaderNoOutput  string
+	PlanHeaderYesOutput string
+	PlanHeaderGenConfig string
+}
+
+// defaultCatalog is Terraform's built-in English message catalog.
+var defaultCatalog = Catalog{
+	Interrupted:         interrupted,
+	FatalInterrupt:      fatalInterrupt,
+	DestroyCancelled:    "Destroy cancelled.",
+	ApplyCancelled:      "Apply cancelled.",
+	PlanHeaderNoOutput:  planHeaderNoOutput,
+	PlanHeaderYesOutput: planHeaderYesOutput,
+	PlanHeaderGenConfig: planHeaderGenConfig,
+}
+
+// activeCatalog is consulted by OperationHuman when rendering its fixed
+// messages. It defaults to defaultCatalog and can be replaced with
+// SetCatalog to localize Terraform's output.
+var activeCatalog = defaultCatalog
+
+// DefaultCatalog returns Terraform's built-in English message catalog, for
+// use as a base when building a localized one.
+func DefaultCatalog() Catalog {
+	return defaultCatalog
+}
+
+// SetCatalog replaces the message catalog used for the fixed strings
+// rendered by OperationHuman. Callers should build the replacement from
+// DefaultCatalog, overriding only the fields they have translations for.
+func SetCatalog(c Catalog) {
+	activeCatalog = c
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package views
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform/internal/plans"
+	"github.com/hashicorp/terraform/internal/terraform"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// QueryFileHook streams each list block's query results to a file as they
+// arrive, one JSON object per line, rather than buffering the whole query
+// operation's results in memory before writing them out. This matters for
+// queries that enumerate large inventories of remote objects.
+type QueryFileHook struct {
+	terraform.NilHook
+
+	mu   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+var _ terraform.Hook = (*QueryFileHook)(nil)
+
+// NewQueryFileHook creates (or truncates) the file at path and returns a
+// Hook that appends query results to it as PostListQuery fires.
+func NewQueryFileHook(path string) (*QueryFileHook, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create query output file %s: %w", path, err)
+	}
+	return &QueryFileHook{
+		file: f,
+		enc:  json.NewEncoder(f),
+	}, nil
+}
+
+// queryFileResult is the per-resource record written to the output file.
+type queryFileResult struct {
+	Address     string `json:"address"`
+	Identity    string `json:"identity"`
+	DisplayName string `json:"display_name"`
+}
+
+func (h *QueryFileHook) PostListQuery(id terraform.HookResourceIdentity, results plans.QueryResults, identityVersion int64) (terraform.HookAction, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	data := results.Value.GetAttr("data")
+	for it := data.ElementIterator(); it.Next(); {
+		_, value := it.Element()
+		record := queryFileResult{
+			Address:     id.Addr.String(),
+			Identity:    tfdiags.ObjectToString(value.GetAttr("identity")),
+			DisplayName: value.GetAttr("display_name").AsString(),
+		}
+		if err := h.enc.Encode(record); err != nil {
+			return terraform.HookActionContinue, err
+		}
+	}
+
+	// Flush this block's results to disk as soon as they arrive, instead of
+	// waiting until the file is closed at the end of the operation.
+	return terraform.HookActionContinue, h.file.Sync()
+}
+
+// Close flushes and closes the underlying file. It must be called once the
+// query operation has finished.
+func (h *QueryFileHook) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.file.Close()
+}
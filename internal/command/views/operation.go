@@ -40,10 +40,18 @@ type Operation interface {
 	PolicyResult(addr string, resp policy.EvaluationResponse)
 }
 
-func NewOperation(vt arguments.ViewType, inAutomation bool, view *View) Operation {
+// NewOperation returns an initialized Operation implementation for the given
+// ViewType. outputPlugin is an optional, variadic argument that names a
+// registered jsonformat.OutputPlugin to use in place of Terraform's own
+// human-readable plan rendering; most callers can omit it.
+func NewOperation(vt arguments.ViewType, inAutomation bool, view *View, outputPlugin ...string) Operation {
 	switch vt {
 	case arguments.ViewHuman:
-		return &OperationHuman{view: view, inAutomation: inAutomation}
+		var plugin string
+		if len(outputPlugin) > 0 {
+			plugin = outputPlugin[0]
+		}
+		return &OperationHuman{view: view, inAutomation: inAutomation, outputPlugin: plugin}
 	default:
 		panic(fmt.Sprintf("unknown view type %v", vt))
 	}
@@ -60,16 +68,20 @@ type OperationHuman struct {
 	// some sort of workflow automation tool that abstracts away the
 	// exact commands that are being run.
 	inAutomation bool
+
+	// outputPlugin, if non-empty, names a registered jsonformat.OutputPlugin
+	// to use in place of Terraform's own plan rendering.
+	outputPlugin string
 }
 
 var _ Operation = (*OperationHuman)(nil)
 
 func (v *OperationHuman) Interrupted() {
-	v.view.streams.Println(format.WordWrap(interrupted, v.view.outputColumns()))
+	v.view.streams.Println(format.WordWrap(activeCatalog.Interrupted, v.view.outputColumns()))
 }
 
 func (v *OperationHuman) FatalInterrupt() {
-	v.view.streams.Eprintln(format.WordWrap(fatalInterrupt, v.view.errorColumns()))
+	v.view.streams.Eprintln(format.WordWrap(activeCatalog.FatalInterrupt, v.view.errorColumns()))
 }
 
 func (v *OperationHuman) Stopping() {
@@ -79,9 +91,9 @@ func (v *OperationHuman) Stopping() {
 func (v *OperationHuman) Cancelled(planMode plans.Mode) {
 	switch planMode {
 	case plans.DestroyMode:
-		v.view.streams.Println("Destroy cancelled.")
+		v.view.streams.Println(activeCatalog.DestroyCancelled)
 	default:
-		v.view.streams.Println("Apply cancelled.")
+		v.view.streams.Println(activeCatalog.ApplyCancelled)
 	}
 }
 
@@ -107,6 +119,14 @@ func (v *OperationHuman) Plan(plan *plans.Plan, schemas *terraform.Schemas) {
 		Streams:             v.view.streams,
 		RunningInAutomation: v.inAutomation,
 	}
+	if v.outputPlugin != "" {
+		plugin, ok := jsonformat.LookupOutputPlugin(v.outputPlugin)
+		if !ok {
+			v.view.streams.Eprintf("Unknown output plugin %q; falling back to the built-in plan renderer.\n", v.outputPlugin)
+		} else {
+			renderer.OutputPlugin = plugin
+		}
+	}
 
 	jplan := jsonformat.Plan{
 		PlanFormatVersion:     jsonplan.FormatVersion,
@@ -161,7 +181,7 @@ func (v *OperationHuman) PlanNextStep(planPath string, genConfigPath string) {
 	if genConfigPath != "" {
 		v.view.streams.Println(
 			format.WordWrap(
-				"\n"+strings.TrimSpace(fmt.Sprintf(planHeaderGenConfig, genConfigPath)),
+				"\n"+strings.TrimSpace(fmt.Sprintf(activeCatalog.PlanHeaderGenConfig, genConfigPath)),
 				v.view.outputColumns(),
 			))
 	}
@@ -169,14 +189,14 @@ func (v *OperationHuman) PlanNextStep(planPath string, genConfigPath string) {
 	if planPath == "" {
 		v.view.streams.Println(
 			format.WordWrap(
-				"\n"+strings.TrimSpace(planHeaderNoOutput),
+				"\n"+strings.TrimSpace(activeCatalog.PlanHeaderNoOutput),
 				v.view.outputColumns(),
 			),
 		)
 	} else {
 		v.view.streams.Println(
 			format.WordWrap(
-				"\n"+strings.TrimSpace(fmt.Sprintf(planHeaderYesOutput, planPath, planPath)),
+				"\n"+strings.TrimSpace(fmt.Sprintf(activeCatalog.PlanHeaderYesOutput, planPath, planPath)),
 				v.view.outputColumns(),
 			),
 		)
@@ -61,6 +61,41 @@ func TestOperation_cancelled(t *testing.T) {
 	}
 }
 
+func TestOperation_cancelledLocalized(t *testing.T) {
+	defer SetCatalog(DefaultCatalog())
+
+	catalog := DefaultCatalog()
+	catalog.ApplyCancelled = "Application annulée."
+	catalog.DestroyCancelled = "Destruction annulée."
+	SetCatalog(catalog)
+
+	testCases := map[string]struct {
+		planMode plans.Mode
+		want     string
+	}{
+		"apply": {
+			planMode: plans.NormalMode,
+			want:     "Application annulée.\n",
+		},
+		"destroy": {
+			planMode: plans.DestroyMode,
+			want:     "Destruction annulée.\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			streams, done := terminal.StreamsForTesting(t)
+			v := NewOperation(arguments.ViewHuman, false, NewView(streams))
+
+			v.Cancelled(tc.planMode)
+
+			if got, want := done(t).Stdout(), tc.want; got != want {
+				t.Errorf("wrong result\ngot:  %q\nwant: %q", got, want)
+			}
+		})
+	}
+}
+
 func TestOperation_emergencyDumpState(t *testing.T) {
 	streams, done := terminal.StreamsForTesting(t)
 	v := NewOperation(arguments.ViewHuman, false, NewView(streams))
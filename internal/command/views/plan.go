@@ -21,7 +21,11 @@ type Plan interface {
 }
 
 // NewPlan returns an initialized Plan implementation for the given ViewType.
-func NewPlan(vt arguments.ViewType, view *View) Plan {
+//
+// outputPlugin optionally names a registered jsonformat.OutputPlugin
+// (selected via the -output-plugin flag) to use instead of Terraform's
+// built-in human-readable plan rendering. It has no effect on the JSON view.
+func NewPlan(vt arguments.ViewType, view *View, outputPlugin string) Plan {
 	switch vt {
 	case arguments.ViewJSON:
 		return &PlanJSON{
@@ -31,6 +35,7 @@ func NewPlan(vt arguments.ViewType, view *View) Plan {
 		return &PlanHuman{
 			view:         view,
 			inAutomation: view.RunningInAutomation(),
+			outputPlugin: outputPlugin,
 		}
 	default:
 		panic(fmt.Sprintf("unknown view type %v", vt))
@@ -43,12 +48,16 @@ type PlanHuman struct {
 	view *View
 
 	inAutomation bool
+
+	// outputPlugin, if non-empty, names a registered jsonformat.OutputPlugin
+	// to use in place of Terraform's own plan rendering.
+	outputPlugin string
 }
 
 var _ Plan = (*PlanHuman)(nil)
 
 func (v *PlanHuman) Operation() Operation {
-	return NewOperation(arguments.ViewHuman, v.inAutomation, v.view)
+	return NewOperation(arguments.ViewHuman, v.inAutomation, v.view, v.outputPlugin)
 }
 
 func (v *PlanHuman) Hooks() []terraform.Hook {
@@ -17,6 +17,7 @@ import (
 	viewsjson "github.com/hashicorp/terraform/internal/command/views/json"
 	"github.com/hashicorp/terraform/internal/configs"
 	"github.com/hashicorp/terraform/internal/plans"
+	"github.com/hashicorp/terraform/internal/plans/normalizeddiff"
 	"github.com/hashicorp/terraform/internal/states/statefile"
 	"github.com/hashicorp/terraform/internal/terraform"
 	"github.com/hashicorp/terraform/internal/tfdiags"
@@ -33,12 +34,27 @@ type Show interface {
 	DisplayResourceInstanceState(jsonformat.State, tfdiags.Diagnostics) int
 }
 
-func NewShow(vt arguments.ViewType, view *View) Show {
+// NewShow returns an initialized Show implementation for the given ViewType.
+//
+// deferredOnly selects the behavior of `terraform show -deferred`: instead
+// of the full plan, only a summary of the deferred resources and their
+// deferral reasons is printed. It has no effect when displaying a state
+// snapshot rather than a plan.
+//
+// deferredGranularity controls, for the human view only, whether that
+// summary reports one line per deferred resource instance or coalesces
+// instances belonging to the same resource into one line.
+//
+// normalized selects the behavior of `terraform show -json -normalized`:
+// instead of the full JSON plan, only the small, version-stable summary of
+// changes produced by the normalizeddiff package is printed. It's only
+// meaningful for the JSON view.
+func NewShow(vt arguments.ViewType, view *View, deferredOnly bool, deferredGranularity jsonformat.DeferredGranularity, normalized bool) Show {
 	switch vt {
 	case arguments.ViewJSON:
-		return &ShowJSON{view: view}
+		return &ShowJSON{view: view, deferredOnly: deferredOnly, normalized: normalized}
 	case arguments.ViewHuman:
-		return &ShowHuman{view: view}
+		return &ShowHuman{view: view, deferredOnly: deferredOnly, deferredGranularity: deferredGranularity}
 	default:
 		panic(fmt.Sprintf("unknown view type %v", vt))
 	}
@@ -46,6 +62,14 @@ func NewShow(vt arguments.ViewType, view *View) Show {
 
 type ShowHuman struct {
 	view *View
+
+	// deferredOnly, if set, restricts Display to printing a summary of the
+	// plan's deferred resources instead of the full plan.
+	deferredOnly bool
+
+	// deferredGranularity controls whether that summary is reported
+	// per-instance or coalesced per-resource.
+	deferredGranularity jsonformat.DeferredGranularity
 }
 
 var _ Show = (*ShowHuman)(nil)
@@ -87,6 +111,11 @@ func (v *ShowHuman) Display(config *configs.Config, plan *plans.Plan, planJSON *
 			v.view.streams.Eprintf("Couldn't decode renderable JSON plan format: %s", err)
 		}
 
+		if v.deferredOnly {
+			v.view.streams.Print(jsonformat.FormatDeferredResourceChangesHuman(p, v.deferredGranularity))
+			return 0
+		}
+
 		v.view.streams.Print(v.view.colorize.Color(planJSON.RunHeader + "\n"))
 		renderer.RenderHumanPlan(p, planJSON.Mode, planJSON.Qualities...)
 		v.view.streams.Print(v.view.colorize.Color("\n" + planJSON.RunFooter + "\n"))
@@ -108,6 +137,11 @@ func (v *ShowHuman) Display(config *configs.Config, plan *plans.Plan, planJSON *
 			ActionInvocations:     actions,
 		}
 
+		if v.deferredOnly {
+			v.view.streams.Print(jsonformat.FormatDeferredResourceChangesHuman(jplan, v.deferredGranularity))
+			return 0
+		}
+
 		var opts []plans.Quality
 		if plan.Errored {
 			opts = append(opts, plans.Errored)
@@ -147,10 +181,32 @@ func (v *ShowHuman) Diagnostics(diags tfdiags.Diagnostics) {
 
 type ShowJSON struct {
 	view *View
+
+	// deferredOnly, if set, restricts Display to printing only the
+	// deferred_changes portion of a plan's JSON representation.
+	deferredOnly bool
+
+	// normalized, if set, restricts Display to printing the
+	// normalizeddiff summary of a plan's changes instead of the full JSON
+	// plan representation.
+	normalized bool
 }
 
 var _ Show = (*ShowJSON)(nil)
 
+// deferredChangesOnly re-encodes planJSON keeping only its deferred_changes
+// field, for use by `terraform show -deferred -json`.
+func deferredChangesOnly(planJSON []byte) ([]byte, error) {
+	var parsed struct {
+		FormatVersion   string          `json:"format_version"`
+		DeferredChanges json.RawMessage `json:"deferred_changes,omitempty"`
+	}
+	if err := json.Unmarshal(planJSON, &parsed); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(parsed, "", "  ")
+}
+
 func (v *ShowJSON) Display(config *configs.Config, plan *plans.Plan, planJSON *cloudplan.RemotePlanJSON, stateFile *statefile.File, schemas *terraform.Schemas) int {
 	// Prefer to display a pre-built JSON plan, if we got one; then, fall back
 	// to building one ourselves.
@@ -159,15 +215,50 @@ func (v *ShowJSON) Display(config *configs.Config, plan *plans.Plan, planJSON *c
 			v.view.streams.Eprintf("Didn't get external JSON plan format")
 			return 1
 		}
+		if v.normalized {
+			v.view.streams.Eprintf("The -normalized flag is not supported for a cloud plan file")
+			return 1
+		}
+		if v.deferredOnly {
+			out, err := deferredChangesOnly(planJSON.JSONBytes)
+			if err != nil {
+				v.view.streams.Eprintf("Failed to extract deferred changes from json plan: %s", err)
+				return 1
+			}
+			v.view.streams.Println(string(out))
+			return 0
+		}
 		v.view.streams.Println(string(planJSON.JSONBytes))
 	} else if plan != nil {
+		if v.normalized {
+			out, err := json.MarshalIndent(normalizeddiff.Normalize(plan), "", "  ")
+			if err != nil {
+				v.view.streams.Eprintf("Failed to marshal normalized plan to json: %s", err)
+				return 1
+			}
+			v.view.streams.Println(string(out))
+			return 0
+		}
+
 		planJSON, err := jsonplan.Marshal(config, plan, stateFile, schemas)
 
 		if err != nil {
 			v.view.streams.Eprintf("Failed to marshal plan to json: %s", err)
 			return 1
 		}
+		if v.deferredOnly {
+			out, err := deferredChangesOnly(planJSON)
+			if err != nil {
+				v.view.streams.Eprintf("Failed to extract deferred changes from json plan: %s", err)
+				return 1
+			}
+			v.view.streams.Println(string(out))
+			return 0
+		}
 		v.view.streams.Println(string(planJSON))
+	} else if v.normalized {
+		v.view.streams.Eprintf("The -normalized flag requires a plan")
+		return 1
 	} else {
 		// It is possible that there is neither state nor a plan.
 		// That's ok, we'll just return an empty object.
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/internal/addrs"
 	"github.com/hashicorp/terraform/internal/cloud/cloudplan"
 	"github.com/hashicorp/terraform/internal/command/arguments"
+	"github.com/hashicorp/terraform/internal/command/jsonformat"
 	"github.com/hashicorp/terraform/internal/configs/configschema"
 	"github.com/hashicorp/terraform/internal/plans"
 	"github.com/hashicorp/terraform/internal/providers"
@@ -99,7 +100,7 @@ func TestShowHuman(t *testing.T) {
 			streams, done := terminal.StreamsForTesting(t)
 			view := NewView(streams)
 			view.Configure(&arguments.View{NoColor: true})
-			v := NewShow(arguments.ViewHuman, view)
+			v := NewShow(arguments.ViewHuman, view, false, jsonformat.DeferredGranularityInstance, false)
 
 			code := v.Display(nil, testCase.plan, testCase.jsonPlan, testCase.stateFile, testCase.schemas)
 			if code != 0 {
@@ -177,7 +178,7 @@ func TestShowJSON(t *testing.T) {
 			streams, done := terminal.StreamsForTesting(t)
 			view := NewView(streams)
 			view.Configure(&arguments.View{NoColor: true})
-			v := NewShow(arguments.ViewJSON, view)
+			v := NewShow(arguments.ViewJSON, view, false, jsonformat.DeferredGranularityInstance, false)
 
 			schemas := &terraform.Schemas{
 				Providers: map[addrs.Provider]providers.ProviderSchema{
@@ -10,6 +10,14 @@ import (
 // AttributeByPath looks up the Attribute schema which corresponds to the given
 // cty.Path. A nil value is returned if the given path does not correspond to a
 // specific attribute.
+//
+// path may contain cty.IndexStep elements where it passes through a
+// NestingList, NestingSet, or NestingMap block or nested type -- one for
+// each level of such nesting, however deep. Those steps select an element
+// within a collection rather than a field of an object, so they carry no
+// schema information of their own and are intentionally skipped here; the
+// schema is the same for every element of the collection regardless of
+// which one a given value path happens to be indexing into.
 func (b *Block) AttributeByPath(path cty.Path) *Attribute {
 	block := b
 	for i, step := range path {
@@ -40,6 +48,10 @@ func (b *Block) AttributeByPath(path cty.Path) *Attribute {
 // AttributeByPath recurses through a NestedType to look up the Attribute scheme
 // which corresponds to the given cty.Path. A nil value is returned if the given
 // path does not correspond to a specific attribute.
+//
+// As with Block.AttributeByPath, any cty.IndexStep elements in path are
+// skipped, since they select a collection element rather than a field and so
+// don't affect which Attribute a path resolves to.
 func (o *Object) AttributeByPath(path cty.Path) *Attribute {
 	for i, step := range path {
 		switch step := step.(type) {
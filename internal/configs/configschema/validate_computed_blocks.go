@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package configschema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// ValidateNoComputedOnlyBlocks checks body for any nested blocks whose
+// corresponding schema marks them as Computed, which means the provider has
+// declared that their content is always generated by the provider itself
+// and is never meant to be written by the user.
+//
+// Providers sometimes misdeclare a block this way by mistake, in which case
+// a user could write configuration that appears to work but whose content
+// the provider silently ignores. This surfaces that mismatch as a
+// configuration error instead, so it's clear as soon as it's written rather
+// than at apply time.
+func (b *Block) ValidateNoComputedOnlyBlocks(body hcl.Body) (diags hcl.Diagnostics) {
+	if len(b.BlockTypes) == 0 {
+		return nil
+	}
+
+	schema := &hcl.BodySchema{}
+	for typeName := range b.BlockTypes {
+		schema.Blocks = append(schema.Blocks, hcl.BlockHeaderSchema{Type: typeName})
+	}
+
+	content, _, contentDiags := body.PartialContent(schema)
+	diags = append(diags, contentDiags...)
+
+	for _, block := range content.Blocks {
+		blockS := b.BlockTypes[block.Type]
+		if blockS == nil {
+			continue
+		}
+		if blockS.Computed {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Invalid configuration for computed-only block",
+				Detail:   fmt.Sprintf("Block type %q is populated entirely by the provider and cannot be configured.", block.Type),
+				Subject:  block.DefRange.Ptr(),
+			})
+			continue
+		}
+		diags = append(diags, blockS.Block.ValidateNoComputedOnlyBlocks(block.Body)...)
+	}
+
+	return diags
+}
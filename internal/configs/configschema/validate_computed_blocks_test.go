@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package configschema
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestBlockValidateNoComputedOnlyBlocks(t *testing.T) {
+	schema := &Block{
+		BlockTypes: map[string]*NestedBlock{
+			"computed_block": {
+				Nesting: NestingSingle,
+				Block:   Block{Computed: true},
+			},
+			"configurable_block": {
+				Nesting: NestingSingle,
+				Block: Block{
+					Attributes: map[string]*Attribute{
+						"name": {Type: cty.String, Optional: true},
+					},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		src       string
+		wantError bool
+	}{
+		"no blocks configured": {
+			src:       ``,
+			wantError: false,
+		},
+		"configurable block is fine": {
+			src: `
+configurable_block {
+  name = "foo"
+}
+`,
+			wantError: false,
+		},
+		"computed-only block configured": {
+			src: `
+computed_block {
+}
+`,
+			wantError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, parseDiags := hclsyntax.ParseConfig([]byte(test.src), "test.tf", hcl.InitialPos)
+			if parseDiags.HasErrors() {
+				t.Fatalf("unexpected parse errors: %s", parseDiags)
+			}
+
+			diags := schema.ValidateNoComputedOnlyBlocks(f.Body)
+			if got := diags.HasErrors(); got != test.wantError {
+				t.Fatalf("wrong result\ngot:  %v\nwant: %v\ndiags: %s", got, test.wantError, diags)
+			}
+		})
+	}
+}
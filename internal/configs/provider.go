@@ -27,6 +27,14 @@ type Provider struct {
 
 	Config hcl.Body
 
+	// DefaultTags, if set, is an expression evaluating to a map of tag
+	// values that Terraform will merge into the "tags" attribute of any
+	// managed resource configured by this provider, for resource types
+	// whose schema declares a top-level "tags" attribute. Values set
+	// directly in a resource's own configuration take precedence over
+	// these defaults on a per-key basis.
+	DefaultTags hcl.Expression
+
 	DeclRange hcl.Range
 
 	// TODO: this may not be set in some cases, so it is not yet suitable for
@@ -115,6 +123,10 @@ func decodeProviderBlock(block *hcl.Block, testFile bool) (*Provider, hcl.Diagno
 		}
 	}
 
+	if attr, exists := content.Attributes["default_tags"]; exists {
+		provider.DefaultTags = attr.Expr
+	}
+
 	// Reserved attribute names
 	for _, name := range []string{"count", "depends_on", "for_each", "source"} {
 		if attr, exists := content.Attributes[name]; exists {
@@ -267,6 +279,9 @@ var providerBlockSchema = &hcl.BodySchema{
 		{
 			Name: "version",
 		},
+		{
+			Name: "default_tags",
+		},
 
 		// Attribute names reserved for future expansion.
 		{Name: "count"},
@@ -158,6 +158,10 @@ func decodeQueryListBlock(block *hcl.Block) (*Resource, hcl.Diagnostics) {
 		r.List.Limit = attr.Expr
 	}
 
+	if attr, exists := content.Attributes["sort_by"]; exists {
+		r.List.SortBy = attr.Expr
+	}
+
 	// verify that the list block has a config block
 	content, contentDiags = block.Body.Content(&hcl.BodySchema{
 		Attributes: QueryListResourceBlockSchema.Attributes,
@@ -210,6 +214,9 @@ var QueryListResourceBlockSchema = &hcl.BodySchema{
 		{
 			Name: "limit",
 		},
+		{
+			Name: "sort_by",
+		},
 	},
 }
 
@@ -5,6 +5,7 @@ package configs
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
@@ -66,6 +67,39 @@ type ManagedResource struct {
 	IgnoreChanges       []hcl.Traversal
 	IgnoreAllChanges    bool
 
+	// ApplySerial, if set, forces the graph walker to apply this resource's
+	// instances one at a time instead of concurrently with other work,
+	// even though nothing else about the dependency graph would otherwise
+	// require that.
+	ApplySerial bool
+
+	// MaxParallel, if set to a positive number, limits how many instances of
+	// this resource's for_each (or count) expansion the graph walker will
+	// process concurrently, independent of the overall operation-wide
+	// parallelism limit. Zero means no resource-specific limit.
+	MaxParallel int
+
+	// ApplyTimeout, if set to a positive duration, bounds how long apply
+	// will wait for the provider's ApplyResourceChange call to return
+	// before recording a warning that the apply ran long. It does not
+	// cancel the underlying provider call, which the provider protocol has
+	// no way to interrupt. Zero means no timeout is enforced.
+	ApplyTimeout time.Duration
+
+	// RequireApplyConfirmation, if set, flags this resource's instances as
+	// high-risk, so that apply gives a provider implementing
+	// providers.ApplyConfirmer a chance to refuse the apply before it
+	// happens. It has no effect on a provider that doesn't implement that
+	// capability.
+	RequireApplyConfirmation bool
+
+	// UseProviderTransaction, if set, brackets this resource's apply calls
+	// in a provider-level transaction for a provider that implements
+	// providers.Transactional, so that a failed apply is rolled back
+	// instead of left partially applied. It has no effect on a provider
+	// that doesn't implement that capability.
+	UseProviderTransaction bool
+
 	CreateBeforeDestroySet bool
 	PreventDestroySet      bool
 	DestroySet             bool
@@ -80,6 +114,13 @@ type ListResource struct {
 	// Limit is an optional expression that can be used to limit the
 	// number of results returned by the list resource.
 	Limit hcl.Expression
+
+	// SortBy is an optional expression naming an attribute of each result to
+	// sort ascending by, applied client-side after the provider returns its
+	// results. This is useful for providers that don't support sorting
+	// themselves, since the results a provider returns in that case are in
+	// whatever order it happens to enumerate them.
+	SortBy hcl.Expression
 }
 
 func (r *Resource) moduleUniqueKey() string {
@@ -228,6 +269,61 @@ func decodeResourceBlock(block *hcl.Block, override bool, allowExperiments bool)
 				r.Managed.DestroySet = true
 			}
 
+			if attr, exists := lcContent.Attributes["apply_serial"]; exists {
+				valDiags := gohcl.DecodeExpression(attr.Expr, nil, &r.Managed.ApplySerial)
+				diags = append(diags, valDiags...)
+			}
+
+			if attr, exists := lcContent.Attributes["max_parallel"]; exists {
+				valDiags := gohcl.DecodeExpression(attr.Expr, nil, &r.Managed.MaxParallel)
+				diags = append(diags, valDiags...)
+				if r.Managed.MaxParallel < 0 {
+					diags = append(diags, &hcl.Diagnostic{
+						Severity: hcl.DiagError,
+						Summary:  "Invalid max_parallel value",
+						Detail:   "The max_parallel argument must be zero or a positive number.",
+						Subject:  attr.Expr.Range().Ptr(),
+					})
+				}
+			}
+
+			if attr, exists := lcContent.Attributes["require_apply_confirmation"]; exists {
+				valDiags := gohcl.DecodeExpression(attr.Expr, nil, &r.Managed.RequireApplyConfirmation)
+				diags = append(diags, valDiags...)
+			}
+
+			if attr, exists := lcContent.Attributes["use_provider_transaction"]; exists {
+				valDiags := gohcl.DecodeExpression(attr.Expr, nil, &r.Managed.UseProviderTransaction)
+				diags = append(diags, valDiags...)
+			}
+
+			if attr, exists := lcContent.Attributes["apply_timeout"]; exists {
+				var raw string
+				valDiags := gohcl.DecodeExpression(attr.Expr, nil, &raw)
+				diags = append(diags, valDiags...)
+				if !valDiags.HasErrors() {
+					timeout, err := time.ParseDuration(raw)
+					switch {
+					case err != nil:
+						diags = append(diags, &hcl.Diagnostic{
+							Severity: hcl.DiagError,
+							Summary:  "Invalid apply_timeout value",
+							Detail:   fmt.Sprintf("The apply_timeout argument must be a duration string, like \"90s\" or \"10m\": %s.", err),
+							Subject:  attr.Expr.Range().Ptr(),
+						})
+					case timeout <= 0:
+						diags = append(diags, &hcl.Diagnostic{
+							Severity: hcl.DiagError,
+							Summary:  "Invalid apply_timeout value",
+							Detail:   "The apply_timeout argument must be a positive duration.",
+							Subject:  attr.Expr.Range().Ptr(),
+						})
+					default:
+						r.Managed.ApplyTimeout = timeout
+					}
+				}
+			}
+
 			if attr, exists := lcContent.Attributes["ignore_changes"]; exists {
 
 				// ignore_changes can either be a list of relative traversals
@@ -1008,6 +1104,21 @@ var resourceLifecycleBlockSchema = &hcl.BodySchema{
 		{
 			Name: "destroy",
 		},
+		{
+			Name: "apply_serial",
+		},
+		{
+			Name: "max_parallel",
+		},
+		{
+			Name: "apply_timeout",
+		},
+		{
+			Name: "require_apply_confirmation",
+		},
+		{
+			Name: "use_provider_transaction",
+		},
 	},
 	Blocks: []hcl.BlockHeaderSchema{
 		{Type: "precondition"},
@@ -372,6 +372,14 @@ func (g *Graph) Mermaid(opts *DotOpts) []byte {
 	return newMarshalGraph("", g).Mermaid(opts)
 }
 
+// JSON returns a JSON representation of the Graph, with "vertices" and
+// "edges" describing its nodes and the dependency relationships between
+// them. This is intended for tooling that wants to consume the graph
+// structure directly, rather than parsing the dot or mermaid text formats.
+func (g *Graph) JSON() ([]byte, error) {
+	return newMarshalGraph("", g).JSON()
+}
+
 // VertexName returns the name of a vertex.
 func VertexName(raw Vertex) string {
 	switch v := raw.(type) {
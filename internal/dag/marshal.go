@@ -4,6 +4,7 @@
 package dag
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"sort"
@@ -40,6 +41,12 @@ type marshalGraph struct {
 	Cycles [][]*marshalVertex `json:",omitempty"`
 }
 
+// JSON returns an indented JSON encoding of the graph's vertices and
+// edges, suitable for consumption by external tooling.
+func (g *marshalGraph) JSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
 func (g *marshalGraph) vertexByID(id string) *marshalVertex {
 	for _, v := range g.Vertices {
 		if id == v.ID {
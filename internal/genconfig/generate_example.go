@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package genconfig
+
+import (
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// GenerateExampleResourceConfig produces a minimal example configuration for
+// a resource type directly from its schema, without reference to any real
+// remote object. It fills required and optional attributes with
+// placeholders the same way GenerateResourceContents does when there's no
+// known state to generate from, so the output carries the same
+// type-constraint comments an operator would see when Terraform generates
+// config during import.
+//
+// This is intended for documentation and scaffolding, such as a CLI command
+// that lets an operator preview a resource's configuration shape before
+// writing real values into it. Because it only consults the schema and
+// issues no RPCs of its own, it works the same way whether the schema came
+// from a configured provider or was obtained through the stacks runtime's
+// unknownProvider stub, which delegates GetProviderSchema to the
+// unconfigured client.
+func GenerateExampleResourceConfig(resourceType, exampleName string, schema *configschema.Block, provider addrs.LocalProviderConfig) (Resource, tfdiags.Diagnostics) {
+	addr := addrs.AbsResourceInstance{
+		Resource: addrs.ResourceInstance{
+			Resource: addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: resourceType,
+				Name: exampleName,
+			},
+		},
+	}
+	return GenerateResourceContents(addr, schema, provider, cty.NilVal, false)
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package genconfig
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+)
+
+// TestGenerateExampleResourceConfig_golden pins down the exact example
+// configuration Terraform generates for a handful of representative
+// schemas. If one of these fails after an intentional change to the
+// generator, update the expected value to match the new, reviewed output.
+func TestGenerateExampleResourceConfig_golden(t *testing.T) {
+	tcs := map[string]struct {
+		resourceType string
+		schema       *configschema.Block
+		expected     string
+	}{
+		"required_and_optional_attributes": {
+			resourceType: "tfcoremock_simple_resource",
+			schema: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"id": {
+						Type:     cty.String,
+						Computed: true,
+					},
+					"name": {
+						Type:     cty.String,
+						Required: true,
+					},
+					"tags": {
+						Type:     cty.Map(cty.String),
+						Optional: true,
+					},
+				},
+			},
+			expected: `
+resource "tfcoremock_simple_resource" "example" {
+  name = null # REQUIRED string
+  tags = null # OPTIONAL map of string
+}`,
+		},
+		"nested_block": {
+			resourceType: "tfcoremock_nested_resource",
+			schema: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"name": {
+						Type:     cty.String,
+						Required: true,
+					},
+				},
+				BlockTypes: map[string]*configschema.NestedBlock{
+					"network": {
+						Block: configschema.Block{
+							Attributes: map[string]*configschema.Attribute{
+								"cidr": {
+									Type:     cty.String,
+									Required: true,
+								},
+							},
+						},
+						Nesting:  configschema.NestingSingle,
+						MinItems: 1,
+					},
+				},
+			},
+			expected: `
+resource "tfcoremock_nested_resource" "example" {
+  name = null # REQUIRED string
+  network { # REQUIRED block
+    cidr = null # REQUIRED string
+  }
+}`,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			resource, diags := GenerateExampleResourceConfig(tc.resourceType, "example", tc.schema, addrs.LocalProviderConfig{LocalName: "tfcoremock"})
+			if diags.HasErrors() {
+				t.Fatalf("unexpected error: %s", diags.Err())
+			}
+
+			want := strings.TrimSpace(tc.expected)
+			got := strings.TrimSpace(resource.String())
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("unexpected output\n%s", diff)
+			}
+		})
+	}
+}
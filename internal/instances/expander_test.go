@@ -678,6 +678,15 @@ func TestExpanderWithUnknowns(t *testing.T) {
 		if len(got) != 0 {
 			t.Errorf("unexpected known addresses: %#v", got)
 		}
+
+		// An unknown for_each means we can't expand the resource into
+		// concrete instances yet, so the runtime should be able to see that
+		// this resource needs to be treated as deferred.
+		configAddr := resourceAddr.InModule(addrs.RootModule)
+		gotUnknown := ex.UnknownResourceInstances(configAddr)
+		if len(gotUnknown) != 1 {
+			t.Errorf("expected exactly one partially-expanded resource, got %#v", gotUnknown)
+		}
 	})
 	t.Run("resource in root module with unknown count", func(t *testing.T) {
 		resourceAddr := addrs.Resource{
@@ -692,6 +701,14 @@ func TestExpanderWithUnknowns(t *testing.T) {
 		if len(got) != 0 {
 			t.Errorf("unexpected known addresses: %#v", got)
 		}
+
+		// As with unknown for_each, an unknown count means the resource's
+		// instances can't be determined yet and so should be deferrable.
+		configAddr := resourceAddr.InModule(addrs.RootModule)
+		gotUnknown := ex.UnknownResourceInstances(configAddr)
+		if len(gotUnknown) != 1 {
+			t.Errorf("expected exactly one partially-expanded resource, got %#v", gotUnknown)
+		}
 	})
 	t.Run("module with unknown for_each", func(t *testing.T) {
 		moduleCallAddr := addrs.ModuleCall{Name: "foo"}
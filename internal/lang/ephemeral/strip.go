@@ -4,26 +4,54 @@
 package ephemeral
 
 import (
+	"fmt"
+
 	"github.com/zclconf/go-cty/cty"
 
 	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/tfdiags"
 )
 
 // StripWriteOnlyAttributes converts all the write-only attributes in value to
 // null values.
 func StripWriteOnlyAttributes(value cty.Value, schema *configschema.Block) cty.Value {
+	updated, _ := StripWriteOnlyAttributesWithDiagnostics(value, schema)
+	return updated
+}
+
+// StripWriteOnlyAttributesWithDiagnostics behaves like
+// StripWriteOnlyAttributes, but additionally returns a warning diagnostic
+// for each write-only attribute that held a non-null value before being
+// stripped. Callers that can meaningfully report problems back to the user
+// should prefer this over StripWriteOnlyAttributes, so that a write-only
+// value being silently discarded doesn't look like user error went
+// unnoticed.
+func StripWriteOnlyAttributesWithDiagnostics(value cty.Value, schema *configschema.Block) (cty.Value, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	transformer := &writeOnlyTransformer{schema: schema}
 	// writeOnlyTransformer never returns errors, so we don't need to detect
 	// them here.
-	updated, _ := cty.TransformWithTransformer(value, &writeOnlyTransformer{
-		schema: schema,
-	})
-	return updated
+	updated, _ := cty.TransformWithTransformer(value, transformer)
+	for _, path := range transformer.strippedNonNullPaths {
+		diags = diags.Append(tfdiags.AttributeValue(
+			tfdiags.Warning,
+			"Write-only attribute value will be ignored",
+			fmt.Sprintf("A value was provided for the write-only attribute %s, but it cannot be used in this context and will be ignored.", tfdiags.FormatCtyPath(path)),
+			path,
+		))
+	}
+	return updated, diags
 }
 
 var _ cty.Transformer = (*writeOnlyTransformer)(nil)
 
 type writeOnlyTransformer struct {
 	schema *configschema.Block
+
+	// strippedNonNullPaths records the path of each write-only attribute
+	// that held a non-null value before being stripped, so callers can
+	// report on them.
+	strippedNonNullPaths []cty.Path
 }
 
 func (w *writeOnlyTransformer) Enter(path cty.Path, value cty.Value) (cty.Value, error) {
@@ -33,6 +61,11 @@ func (w *writeOnlyTransformer) Enter(path cty.Path, value cty.Value) (cty.Value,
 	}
 
 	if attr.WriteOnly {
+		if !value.IsNull() {
+			pathCopy := make(cty.Path, len(path))
+			copy(pathCopy, path)
+			w.strippedNonNullPaths = append(w.strippedNonNullPaths, pathCopy)
+		}
 		value, marks := value.Unmark()
 		return cty.NullVal(value.Type()).WithMarks(marks), nil
 	}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform/internal/configs/configschema"
 	"github.com/hashicorp/terraform/internal/lang/marks"
+	"github.com/hashicorp/terraform/internal/tfdiags"
 )
 
 func TestStripWriteOnlyAttributes(t *testing.T) {
@@ -164,6 +165,92 @@ func TestStripWriteOnlyAttributes(t *testing.T) {
 				}),
 			}),
 		},
+		"nested in set": {
+			val: cty.ObjectVal(map[string]cty.Value{
+				"value": cty.SetVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"value": cty.StringVal("one"),
+					}),
+					cty.ObjectVal(map[string]cty.Value{
+						"value": cty.StringVal("two"),
+					}),
+				}),
+			}),
+			schema: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"value": {
+						NestedType: &configschema.Object{
+							Attributes: map[string]*configschema.Attribute{
+								"value": {
+									Type:      cty.String,
+									WriteOnly: true,
+								},
+							},
+							Nesting: configschema.NestingSet,
+						},
+					},
+				},
+			},
+			want: cty.ObjectVal(map[string]cty.Value{
+				"value": cty.SetVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"value": cty.NullVal(cty.String),
+					}),
+				}),
+			}),
+		},
+		"write-only attribute three levels deep under a set block": {
+			val: cty.ObjectVal(map[string]cty.Value{
+				"outer": cty.SetVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"inner": cty.ListVal([]cty.Value{
+							cty.ObjectVal(map[string]cty.Value{
+								"secret": cty.StringVal("value-a"),
+							}),
+							cty.ObjectVal(map[string]cty.Value{
+								"secret": cty.StringVal("value-b"),
+							}),
+						}),
+					}),
+				}),
+			}),
+			schema: &configschema.Block{
+				BlockTypes: map[string]*configschema.NestedBlock{
+					"outer": {
+						Nesting: configschema.NestingSet,
+						Block: configschema.Block{
+							BlockTypes: map[string]*configschema.NestedBlock{
+								"inner": {
+									Nesting: configschema.NestingList,
+									Block: configschema.Block{
+										Attributes: map[string]*configschema.Attribute{
+											"secret": {
+												Type:      cty.String,
+												WriteOnly: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: cty.ObjectVal(map[string]cty.Value{
+				"outer": cty.SetVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"inner": cty.ListVal([]cty.Value{
+							cty.ObjectVal(map[string]cty.Value{
+								"secret": cty.NullVal(cty.String),
+							}),
+							cty.ObjectVal(map[string]cty.Value{
+								"secret": cty.NullVal(cty.String),
+							}),
+						}),
+					}),
+				}),
+			}),
+		},
 		"preserves marks": {
 			val: cty.ObjectVal(map[string]cty.Value{
 				"value": cty.StringVal("value"),
@@ -191,3 +278,56 @@ func TestStripWriteOnlyAttributes(t *testing.T) {
 		})
 	}
 }
+
+func TestStripWriteOnlyAttributesWithDiagnostics(t *testing.T) {
+	t.Run("warns when a non-null write-only value is stripped", func(t *testing.T) {
+		schema := &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"value": {
+					Type:      cty.String,
+					WriteOnly: true,
+				},
+			},
+		}
+		val := cty.ObjectVal(map[string]cty.Value{
+			"value": cty.StringVal("secret"),
+		})
+
+		got, diags := StripWriteOnlyAttributesWithDiagnostics(val, schema)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+		if len(diags) != 1 {
+			t.Fatalf("expected 1 diagnostic, got %d: %s", len(diags), diags.Err())
+		}
+		if got, want := diags[0].Severity(), tfdiags.Warning; got != want {
+			t.Fatalf("wrong severity: got %s, want %s", got, want)
+		}
+
+		want := cty.ObjectVal(map[string]cty.Value{
+			"value": cty.NullVal(cty.String),
+		})
+		if diff := cmp.Diff(got, want, ctydebug.CmpOptions); len(diff) > 0 {
+			t.Errorf("got diff:\n%s", diff)
+		}
+	})
+
+	t.Run("no diagnostics when the write-only value is already null", func(t *testing.T) {
+		schema := &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"value": {
+					Type:      cty.String,
+					WriteOnly: true,
+				},
+			},
+		}
+		val := cty.ObjectVal(map[string]cty.Value{
+			"value": cty.NullVal(cty.String),
+		})
+
+		_, diags := StripWriteOnlyAttributesWithDiagnostics(val, schema)
+		if len(diags) != 0 {
+			t.Fatalf("expected no diagnostics, got %s", diags.Err())
+		}
+	})
+}
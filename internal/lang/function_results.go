@@ -48,19 +48,37 @@ func (f *FunctionResults) CheckPrior(name string, args []cty.Value, result cty.V
 	return f.CheckPriorProvider(addrs.Provider{}, name, args, result)
 }
 
-// CheckPriorProvider compares the provider function call against any cached
-// results, and returns an error if the result does not match a prior call.
-func (f *FunctionResults) CheckPriorProvider(provider addrs.Provider, name string, args []cty.Value, result cty.Value) error {
-	// Don't cache unknown values. We could technically store types and
-	// refinements for validation, but we don't currently have a way to
-	// serialize those in the plan. Unknowns are also handled much more
-	// gracefully throughout the evaluation system, whereas invalid data is
-	// harder to trace back to the source since it's usually only visible due to
-	// unexpected side-effects.
-	if !result.IsKnown() {
-		return nil
+// LookupCachedResult returns the result of a previous call to the given
+// provider function with the given arguments, if CheckPriorProvider has
+// already recorded one during the current operation, along with whether one
+// was found.
+//
+// This lets a caller that's about to make a potentially-expensive provider
+// function call with the same function and arguments as one it (or some
+// other module sharing this table) already called earlier in the same
+// operation reuse that earlier result instead of calling the provider
+// again. Provider functions are required to be pure, so a cached result by
+// value is always equally valid, regardless of which module asks for it.
+//
+// Only successful results that are known at the top level are recorded
+// (CheckPriorProvider skips a wholly unknown result, but not one holding
+// unknown values nested inside it), so a call that previously errored is
+// always retried rather than served from cache.
+func (f *FunctionResults) LookupCachedResult(provider addrs.Provider, name string, args []cty.Value) (cty.Value, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res, ok := f.results[hashFunctionCall(provider, name, args)]
+	if !ok || res.value == cty.NilVal {
+		return cty.NilVal, false
 	}
+	return res.value, true
+}
 
+// hashFunctionCall computes the cache key used to correlate calls to the
+// same provider function with the same arguments, for both result
+// consistency checking and result caching.
+func hashFunctionCall(provider addrs.Provider, name string, args []cty.Value) [sha256.Size]byte {
 	argSum := sha256.New()
 
 	if !provider.IsZero() {
@@ -75,10 +93,26 @@ func (f *FunctionResults) CheckPriorProvider(provider addrs.Provider, name strin
 		io.WriteString(argSum, "|"+arg.GoString())
 	}
 
+	return [sha256.Size]byte(argSum.Sum(nil))
+}
+
+// CheckPriorProvider compares the provider function call against any cached
+// results, and returns an error if the result does not match a prior call.
+func (f *FunctionResults) CheckPriorProvider(provider addrs.Provider, name string, args []cty.Value, result cty.Value) error {
+	// Don't cache unknown values. We could technically store types and
+	// refinements for validation, but we don't currently have a way to
+	// serialize those in the plan. Unknowns are also handled much more
+	// gracefully throughout the evaluation system, whereas invalid data is
+	// harder to trace back to the source since it's usually only visible due to
+	// unexpected side-effects.
+	if !result.IsKnown() {
+		return nil
+	}
+
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	argHash := [sha256.Size]byte(argSum.Sum(nil))
+	argHash := hashFunctionCall(provider, name, args)
 	resHash := sha256.Sum256([]byte(result.GoString()))
 
 	res, ok := f.results[argHash]
@@ -188,3 +188,45 @@ func TestFunctionCache(t *testing.T) {
 		})
 	}
 }
+
+func TestFunctionResults_LookupCachedResult(t *testing.T) {
+	testAddr := addrs.NewDefaultProvider("test")
+	args := []cty.Value{cty.StringVal("ok")}
+
+	results := NewFunctionResultsTable(nil)
+
+	if _, ok := results.LookupCachedResult(testAddr, "fun", args); ok {
+		t.Fatal("expected no cached result before any call was recorded")
+	}
+
+	if err := results.CheckPriorProvider(testAddr, "fun", args, cty.True); err != nil {
+		t.Fatal(err)
+	}
+
+	got, ok := results.LookupCachedResult(testAddr, "fun", args)
+	if !ok {
+		t.Fatal("expected a cached result after a successful call was recorded")
+	}
+	if !got.RawEquals(cty.True) {
+		t.Fatalf("wrong cached result: got %#v, want %#v", got, cty.True)
+	}
+
+	// A different provider, name, or argument set must not hit the cache.
+	if _, ok := results.LookupCachedResult(addrs.NewDefaultProvider("other"), "fun", args); ok {
+		t.Fatal("expected no cached result for a different provider")
+	}
+	if _, ok := results.LookupCachedResult(testAddr, "other", args); ok {
+		t.Fatal("expected no cached result for a different function name")
+	}
+	if _, ok := results.LookupCachedResult(testAddr, "fun", []cty.Value{cty.StringVal("different")}); ok {
+		t.Fatal("expected no cached result for different arguments")
+	}
+
+	// An unknown result is never cached.
+	if err := results.CheckPriorProvider(testAddr, "unknown_fn", args, cty.UnknownVal(cty.Bool)); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := results.LookupCachedResult(testAddr, "unknown_fn", args); ok {
+		t.Fatal("expected no cached result for a call that returned an unknown value")
+	}
+}
@@ -10,23 +10,80 @@ import (
 	"github.com/zclconf/go-cty/cty"
 
 	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/lang/format"
 	"github.com/hashicorp/terraform/internal/tfdiags"
 )
 
+// PlanValuesOption customizes the behavior of PlanComputedValuesForResource.
+type PlanValuesOption func(*planValuesOptions)
+
+type planValuesOptions struct {
+	knownDefaults map[string]cty.Value
+}
+
+// WithKnownDefault tells PlanComputedValuesForResource to use value, rather
+// than an unknown value, for the computed attribute at path. This is for
+// callers that are mocking a provider whose configuration (and therefore
+// whose actual planning behavior) isn't known yet, but which still know
+// that some of that provider's computed attributes have predictable
+// defaults -- so that downstream references to those specific attributes
+// don't have to become unknown, and anything depending on them doesn't
+// have to be deferred along with the provider configuration itself.
+//
+// path is matched against the schema using the same formatting as
+// tfdiags' path-based diagnostics, so it should consist only of attribute
+// name steps; this option has no effect on attributes reached only through
+// block or collection steps.
+func WithKnownDefault(path cty.Path, value cty.Value) PlanValuesOption {
+	return func(opts *planValuesOptions) {
+		if opts.knownDefaults == nil {
+			opts.knownDefaults = make(map[string]cty.Value)
+		}
+		opts.knownDefaults[format.CtyPath(path)] = value
+	}
+}
+
 // PlanComputedValuesForResource accepts a target value, and populates its computed
 // values with values from the provider 'with' argument, and if 'with' is not provided,
 // it sets the computed values to cty.UnknownVal.
 //
 // The latter behaviour simulates the behaviour of a plan request in a real
-// provider.
-func PlanComputedValuesForResource(original cty.Value, with *MockedData, schema *configschema.Block) (cty.Value, tfdiags.Diagnostics) {
+// provider. Any attribute registered with WithKnownDefault is given its
+// specified value instead, even when it would otherwise become unknown.
+func PlanComputedValuesForResource(original cty.Value, with *MockedData, schema *configschema.Block, opts ...PlanValuesOption) (cty.Value, tfdiags.Diagnostics) {
 	if with == nil {
 		with = &MockedData{
 			Value:             cty.NilVal,
 			ComputedAsUnknown: true,
 		}
 	}
-	return populateComputedValues(original, *with, schema, isNull)
+
+	var options planValuesOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	value, diags := populateComputedValues(original, *with, schema, isNull)
+	if len(options.knownDefaults) == 0 {
+		return value, diags
+	}
+
+	value, err := cty.Transform(value, func(path cty.Path, target cty.Value) (cty.Value, error) {
+		if known, ok := options.knownDefaults[format.CtyPath(path)]; ok {
+			return known, nil
+		}
+		return target, nil
+	})
+	if err != nil {
+		// cty.Transform's callback above never returns an error, so this
+		// should be unreachable.
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Failed to generate values",
+			Detail:   fmt.Sprintf("Terraform failed to apply known computed value defaults for a mocked resource: %s. This is a bug in Terraform - please report it.", err),
+		})
+	}
+	return value, diags
 }
 
 // ApplyComputedValuesForResource accepts a target value, and populates it
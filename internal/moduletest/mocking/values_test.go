@@ -1001,3 +1001,72 @@ func TestComputedValuesForDataSource(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanComputedValuesForResource_knownDefaults(t *testing.T) {
+	schema := &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"id": {
+				Type:     cty.String,
+				Computed: true,
+			},
+			"region": {
+				Type:     cty.String,
+				Computed: true,
+			},
+			"value": {
+				Type: cty.String,
+			},
+		},
+	}
+
+	target := cty.ObjectVal(map[string]cty.Value{
+		"id":     cty.NullVal(cty.String),
+		"region": cty.NullVal(cty.String),
+		"value":  cty.StringVal("Hello, world!"),
+	})
+
+	actual, diags := PlanComputedValuesForResource(target, nil, schema,
+		WithKnownDefault(cty.GetAttrPath("region"), cty.StringVal("us-east-1")),
+	)
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags.Err())
+	}
+
+	expected := cty.ObjectVal(map[string]cty.Value{
+		"id":     cty.UnknownVal(cty.String),
+		"region": cty.StringVal("us-east-1"),
+		"value":  cty.StringVal("Hello, world!"),
+	})
+	if actual.Equals(expected).False() {
+		t.Fatalf("\nexpected: (%s)\nactual:   (%s)", expected.GoString(), actual.GoString())
+	}
+}
+
+func TestPlanComputedValuesForResource_knownDefaultDoesNotApplyElsewhere(t *testing.T) {
+	schema := &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"id": {
+				Type:     cty.String,
+				Computed: true,
+			},
+		},
+	}
+
+	target := cty.ObjectVal(map[string]cty.Value{
+		"id": cty.NullVal(cty.String),
+	})
+
+	actual, diags := PlanComputedValuesForResource(target, nil, schema,
+		WithKnownDefault(cty.GetAttrPath("region"), cty.StringVal("us-east-1")),
+	)
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags.Err())
+	}
+
+	expected := cty.ObjectVal(map[string]cty.Value{
+		"id": cty.UnknownVal(cty.String),
+	})
+	if actual.Equals(expected).False() {
+		t.Fatalf("\nexpected: (%s)\nactual:   (%s)", expected.GoString(), actual.GoString())
+	}
+}
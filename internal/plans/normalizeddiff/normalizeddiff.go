@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package normalizeddiff produces a small, stable summary of the changes in
+// a plan that stays the same across Terraform versions, so that it's safe
+// for operators to diff between runs or archive for later comparison.
+//
+// This is deliberately much narrower than the full JSON plan representation
+// produced by jsonplan: it records only the address, action, and deferral
+// status of each resource instance, none of which are expected to change in
+// representation as Terraform evolves. It does not include attribute values,
+// since their rendering is sensitive to provider schema changes.
+package normalizeddiff
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform/internal/plans"
+)
+
+// FormatVersion identifies the shape of the Diff structure produced by this
+// package. It should be incremented only when making a breaking change to
+// that structure.
+const FormatVersion = "1.0"
+
+// Diff is the normalized, version-stable representation of a plan's
+// changes.
+type Diff struct {
+	FormatVersion string   `json:"format_version"`
+	Changes       []Change `json:"changes,omitempty"`
+}
+
+// Change describes a single planned change to a resource instance.
+type Change struct {
+	// Address is the absolute resource instance address the change applies
+	// to.
+	Address string `json:"address"`
+
+	// Action is the stable name of the plans.Action this change represents,
+	// such as "Create", "Update", or "Delete".
+	Action string `json:"action"`
+
+	// Deferred is true if this change could not be finalized during
+	// planning, for example because its provider configuration was not yet
+	// known.
+	Deferred bool `json:"deferred,omitempty"`
+
+	// DeferredReason is the stable name of the providers.DeferredReason this
+	// change was deferred for. It's empty unless Deferred is true.
+	DeferredReason string `json:"deferred_reason,omitempty"`
+}
+
+// Normalize builds a Diff summarizing the changes in plan.
+func Normalize(plan *plans.Plan) Diff {
+	diff := Diff{FormatVersion: FormatVersion}
+	if plan == nil {
+		return diff
+	}
+
+	if plan.Changes != nil {
+		for _, rc := range plan.Changes.Resources {
+			if rc.Action == plans.NoOp && !rc.Moved() {
+				continue
+			}
+			diff.Changes = append(diff.Changes, Change{
+				Address: rc.Addr.String(),
+				Action:  rc.Action.String(),
+			})
+		}
+	}
+
+	for _, dc := range plan.DeferredResources {
+		change := Change{
+			Deferred:       true,
+			DeferredReason: string(dc.DeferredReason),
+		}
+		if dc.ChangeSrc != nil {
+			change.Address = dc.ChangeSrc.Addr.String()
+			change.Action = dc.ChangeSrc.Action.String()
+		}
+		diff.Changes = append(diff.Changes, change)
+	}
+
+	sort.Slice(diff.Changes, func(i, j int) bool {
+		if diff.Changes[i].Address != diff.Changes[j].Address {
+			return diff.Changes[i].Address < diff.Changes[j].Address
+		}
+		return diff.Changes[i].Deferred != diff.Changes[j].Deferred && !diff.Changes[i].Deferred
+	})
+
+	return diff
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package normalizeddiff
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/plans"
+	"github.com/hashicorp/terraform/internal/providers"
+)
+
+func resourceAddr(name string) addrs.AbsResourceInstance {
+	return addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_thing",
+		Name: name,
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+}
+
+func TestNormalize(t *testing.T) {
+	plan := &plans.Plan{
+		Changes: &plans.ChangesSrc{
+			Resources: []*plans.ResourceInstanceChangeSrc{
+				{
+					Addr:        resourceAddr("created"),
+					PrevRunAddr: resourceAddr("created"),
+					ChangeSrc:   plans.ChangeSrc{Action: plans.Create},
+				},
+				{
+					Addr:        resourceAddr("updated"),
+					PrevRunAddr: resourceAddr("updated"),
+					ChangeSrc:   plans.ChangeSrc{Action: plans.Update},
+				},
+				{
+					Addr:        resourceAddr("deleted"),
+					PrevRunAddr: resourceAddr("deleted"),
+					ChangeSrc:   plans.ChangeSrc{Action: plans.Delete},
+				},
+				{
+					Addr:        resourceAddr("unchanged"),
+					PrevRunAddr: resourceAddr("unchanged"),
+					ChangeSrc:   plans.ChangeSrc{Action: plans.NoOp},
+				},
+			},
+		},
+		DeferredResources: []*plans.DeferredResourceInstanceChangeSrc{
+			{
+				DeferredReason: providers.DeferredReasonProviderConfigUnknown,
+				ChangeSrc: &plans.ResourceInstanceChangeSrc{
+					Addr:        resourceAddr("deferred"),
+					PrevRunAddr: resourceAddr("deferred"),
+					ChangeSrc:   plans.ChangeSrc{Action: plans.Create},
+				},
+			},
+		},
+	}
+
+	got, err := json.MarshalIndent(Normalize(plan), "", "  ")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{
+  "format_version": "1.0",
+  "changes": [
+    {
+      "address": "test_thing.created",
+      "action": "Create"
+    },
+    {
+      "address": "test_thing.deferred",
+      "action": "Create",
+      "deferred": true,
+      "deferred_reason": "provider_config_unknown"
+    },
+    {
+      "address": "test_thing.deleted",
+      "action": "Delete"
+    },
+    {
+      "address": "test_thing.updated",
+      "action": "Update"
+    }
+  ]
+}`
+
+	if string(got) != want {
+		t.Fatalf("wrong normalized diff\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestNormalize_nilPlan(t *testing.T) {
+	got, err := json.Marshal(Normalize(nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"format_version":"1.0"}`
+	if string(got) != want {
+		t.Fatalf("wrong normalized diff\ngot:  %s\nwant: %s", got, want)
+	}
+}
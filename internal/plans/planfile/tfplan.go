@@ -6,6 +6,7 @@ package planfile
 import (
 	"fmt"
 	"io"
+	"log"
 	"slices"
 	"time"
 
@@ -31,6 +32,15 @@ const (
 	tfplanFilename      = "tfplan"
 )
 
+// The deferral section of a plan file (the DeferredChanges and
+// DeferredActionInvocations fields of planproto.Plan) doesn't have its own
+// separate format version. Instead, DeferredReason is the versioned part:
+// new reasons can be added to the planproto.DeferredReason enum without
+// bumping tfplanFormatVersion, and deferredChangeFromTfplan /
+// deferredActionInvocationFromTfplan treat a reason they don't recognize as
+// a forward-compatibility signal -- logging a warning and still recording
+// the change as deferred -- rather than refusing to read the whole plan.
+
 // ---------------------------------------------------------------------------
 // This file deals with the internal structure of the "tfplan" sub-file within
 // the plan file format. It's all private API, wrapped by methods defined
@@ -557,7 +567,13 @@ func deferredChangeFromTfplan(dc *planproto.DeferredResourceInstanceChange) (*pl
 
 	reason, err := DeferredReasonFromProto(dc.Deferred.Reason)
 	if err != nil {
-		return nil, err
+		// An unrecognized reason means this plan was written by a newer
+		// version of Terraform that added a DeferredReason this build
+		// doesn't know about yet. That's not a reason to refuse the whole
+		// plan: we still record the change as deferred, just without being
+		// able to say exactly why, which is enough for callers that only
+		// need to know the change can't be applied yet.
+		log.Printf("[WARN] planfile: %s; recording this deferral with reason %q", err, reason)
 	}
 
 	return &plans.DeferredResourceInstanceChangeSrc{
@@ -578,7 +594,11 @@ func deferredActionInvocationFromTfplan(dai *planproto.DeferredActionInvocation)
 
 	reason, err := DeferredReasonFromProto(dai.Deferred.Reason)
 	if err != nil {
-		return nil, err
+		// See the equivalent comment in deferredChangeFromTfplan: an
+		// unrecognized reason here means a newer Terraform wrote this plan,
+		// not that the file is corrupt, so we keep reading rather than
+		// failing the whole plan over it.
+		log.Printf("[WARN] planfile: %s; recording this deferral with reason %q", err, reason)
 	}
 
 	return &plans.DeferredActionInvocationSrc{
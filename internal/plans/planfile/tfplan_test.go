@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform/internal/configs/configschema"
 	"github.com/hashicorp/terraform/internal/lang/globalref"
 	"github.com/hashicorp/terraform/internal/plans"
+	"github.com/hashicorp/terraform/internal/plans/planproto"
 	"github.com/hashicorp/terraform/internal/providers"
 	"github.com/hashicorp/terraform/internal/states"
 )
@@ -690,3 +691,151 @@ func TestTFPlanRoundTripDestroy(t *testing.T) {
 		}
 	}
 }
+
+// TestTFPlanRoundTrip_deferralSection covers reading plan files both with
+// and without a deferral section, to make sure the presence or absence of
+// DeferredChanges and DeferredActionInvocations never affects whether the
+// rest of the plan can be read.
+func TestTFPlanRoundTrip_deferralSection(t *testing.T) {
+	t.Run("without a deferral section", func(t *testing.T) {
+		plan := examplePlanForTest(t)
+		if len(plan.DeferredResources) != 0 || len(plan.DeferredActionInvocations) != 0 {
+			t.Fatal("test fixture unexpectedly already has deferral data")
+		}
+
+		var buf bytes.Buffer
+		if err := writeTfplan(plan, &buf); err != nil {
+			t.Fatal(err)
+		}
+
+		newPlan, err := readTfplan(&buf)
+		if err != nil {
+			t.Fatalf("unexpected error reading a plan without a deferral section: %s", err)
+		}
+		if len(newPlan.DeferredResources) != 0 || len(newPlan.DeferredActionInvocations) != 0 {
+			t.Fatal("expected no deferral data after round-tripping a plan that had none")
+		}
+	})
+
+	t.Run("with a deferral section", func(t *testing.T) {
+		plan := examplePlanForTest(t)
+		plan.DeferredResources = []*plans.DeferredResourceInstanceChangeSrc{
+			{
+				DeferredReason: providers.DeferredReasonProviderConfigUnknown,
+				ChangeSrc: &plans.ResourceInstanceChangeSrc{
+					Addr: addrs.Resource{
+						Mode: addrs.ManagedResourceMode,
+						Type: "test_thing",
+						Name: "woot",
+					}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+					ProviderAddr: addrs.AbsProviderConfig{
+						Provider: addrs.NewDefaultProvider("test"),
+						Module:   addrs.RootModule,
+					},
+					ChangeSrc: plans.ChangeSrc{
+						Action: plans.Create,
+						After: mustNewDynamicValue(cty.ObjectVal(map[string]cty.Value{
+							"id": cty.UnknownVal(cty.String),
+						}), cty.Object(map[string]cty.Type{"id": cty.String})),
+					},
+				},
+			},
+		}
+
+		var buf bytes.Buffer
+		if err := writeTfplan(plan, &buf); err != nil {
+			t.Fatal(err)
+		}
+
+		newPlan, err := readTfplan(&buf)
+		if err != nil {
+			t.Fatalf("unexpected error reading a plan with a deferral section: %s", err)
+		}
+		if len(newPlan.DeferredResources) != 1 {
+			t.Fatalf("expected 1 deferred resource, got %d", len(newPlan.DeferredResources))
+		}
+		if newPlan.DeferredResources[0].DeferredReason != providers.DeferredReasonProviderConfigUnknown {
+			t.Fatalf("wrong deferred reason: %s", newPlan.DeferredResources[0].DeferredReason)
+		}
+	})
+}
+
+// TestDeferredReasonFromProto_unrecognizedReason confirms that a deferral
+// whose reason this build doesn't recognize -- as would happen reading a
+// plan written by a newer Terraform that added a new DeferredReason --
+// doesn't cause the whole plan to fail to load. This is the
+// backward-compatible reading behavior for the deferral section described
+// in the comment following the tfplanFormatVersion constant.
+func TestDeferredReasonFromProto_unrecognizedReason(t *testing.T) {
+	t.Run("deferred resource change", func(t *testing.T) {
+		src := &plans.DeferredResourceInstanceChangeSrc{
+			DeferredReason: providers.DeferredReasonInstanceCountUnknown,
+			ChangeSrc: &plans.ResourceInstanceChangeSrc{
+				Addr: addrs.Resource{
+					Mode: addrs.ManagedResourceMode,
+					Type: "test_thing",
+					Name: "woot",
+				}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+				ProviderAddr: addrs.AbsProviderConfig{
+					Provider: addrs.NewDefaultProvider("test"),
+					Module:   addrs.RootModule,
+				},
+				ChangeSrc: plans.ChangeSrc{
+					Action: plans.Create,
+					After: mustNewDynamicValue(cty.ObjectVal(map[string]cty.Value{
+						"id": cty.UnknownVal(cty.String),
+					}), cty.Object(map[string]cty.Type{"id": cty.String})),
+				},
+			},
+		}
+		raw, err := deferredChangeToTfplan(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Simulate a plan written by a future Terraform that understands a
+		// DeferredReason this build has never heard of.
+		raw.Deferred.Reason = planproto.DeferredReason(12345)
+
+		got, err := deferredChangeFromTfplan(raw)
+		if err != nil {
+			t.Fatalf("expected no error for an unrecognized deferral reason, got: %s", err)
+		}
+		if got.DeferredReason != providers.DeferredReasonInvalid {
+			t.Fatalf("expected DeferredReasonInvalid, got %s", got.DeferredReason)
+		}
+	})
+
+	t.Run("deferred action invocation", func(t *testing.T) {
+		src := &plans.DeferredActionInvocationSrc{
+			DeferredReason: providers.DeferredReasonDeferredPrereq,
+			ActionInvocationInstanceSrc: &plans.ActionInvocationInstanceSrc{
+				Addr: addrs.Action{Type: "test_action", Name: "example"}.Absolute(addrs.RootModuleInstance).Instance(addrs.NoKey),
+				ActionTrigger: &plans.ResourceActionTrigger{
+					TriggeringResourceAddr: addrs.Resource{
+						Mode: addrs.ManagedResourceMode,
+						Type: "test_thing",
+						Name: "woot",
+					}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+					ActionTriggerBlockIndex: 0,
+					ActionsListIndex:        0,
+					ActionTriggerEvent:      configs.AfterCreate,
+				},
+			},
+		}
+		raw, err := deferredActionInvocationToTfplan(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		raw.Deferred.Reason = planproto.DeferredReason(12345)
+
+		got, err := deferredActionInvocationFromTfplan(raw)
+		if err != nil {
+			t.Fatalf("expected no error for an unrecognized deferral reason, got: %s", err)
+		}
+		if got.DeferredReason != providers.DeferredReasonInvalid {
+			t.Fatalf("expected DeferredReasonInvalid, got %s", got.DeferredReason)
+		}
+	})
+}
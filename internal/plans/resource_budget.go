@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package plans
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// CheckResourceCreateBudget counts the resource instances this plan would
+// create -- including those recorded as deferred, which are counted
+// separately since a deferred create hasn't actually been scheduled yet --
+// and returns an error diagnostic if the non-deferred count exceeds limit.
+//
+// A limit of zero or less is treated as "no limit", so callers don't need to
+// special-case an unset budget themselves.
+func (p *Plan) CheckResourceCreateBudget(limit int) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	if limit <= 0 {
+		return diags
+	}
+
+	var creates, deferredCreates int
+	for _, rc := range p.Changes.Resources {
+		if resourceChangeCreates(rc.Action) {
+			creates++
+		}
+	}
+	for _, d := range p.DeferredResources {
+		if d.ChangeSrc != nil && resourceChangeCreates(d.ChangeSrc.Action) {
+			deferredCreates++
+		}
+	}
+
+	if creates <= limit {
+		return diags
+	}
+
+	diags = diags.Append(tfdiags.Sourceless(
+		tfdiags.Error,
+		"Plan exceeds resource creation budget",
+		fmt.Sprintf(
+			"This plan would create %d resource instances, which exceeds the configured budget of %d. %d additional resource instance(s) are deferred and not counted against the budget.",
+			creates, limit, deferredCreates,
+		),
+	))
+	return diags
+}
+
+// resourceChangeCreates reports whether action represents a change that
+// creates a new remote object, whether or not it also replaces an existing
+// one.
+func resourceChangeCreates(action Action) bool {
+	switch action {
+	case Create, CreateThenDelete, CreateThenForget, DeleteThenCreate:
+		return true
+	default:
+		return false
+	}
+}
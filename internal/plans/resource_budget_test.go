@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package plans
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/providers"
+)
+
+func TestPlan_CheckResourceCreateBudget(t *testing.T) {
+	t.Run("no limit set", func(t *testing.T) {
+		plan := &Plan{
+			Changes: &ChangesSrc{
+				Resources: []*ResourceInstanceChangeSrc{
+					{Action: Create},
+					{Action: Create},
+				},
+			},
+		}
+
+		diags := plan.CheckResourceCreateBudget(0)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+	})
+
+	t.Run("within budget", func(t *testing.T) {
+		plan := &Plan{
+			Changes: &ChangesSrc{
+				Resources: []*ResourceInstanceChangeSrc{
+					{Action: Create},
+					{Action: Update},
+					{Action: Delete},
+				},
+			},
+		}
+
+		diags := plan.CheckResourceCreateBudget(1)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+	})
+
+	t.Run("exceeds budget", func(t *testing.T) {
+		plan := &Plan{
+			Changes: &ChangesSrc{
+				Resources: []*ResourceInstanceChangeSrc{
+					{Action: Create},
+					{Action: Create},
+					{Action: CreateThenDelete},
+				},
+			},
+		}
+
+		diags := plan.CheckResourceCreateBudget(2)
+		if !diags.HasErrors() {
+			t.Fatal("expected an error, but there was none")
+		}
+		if !strings.Contains(diags.Err().Error(), "3 resource instances") {
+			t.Fatalf("expected the error to report the actual count, got: %s", diags.Err())
+		}
+	})
+
+	t.Run("deferred creates are counted separately and don't count against the budget", func(t *testing.T) {
+		plan := &Plan{
+			Changes: &ChangesSrc{
+				Resources: []*ResourceInstanceChangeSrc{
+					{Action: Create},
+				},
+			},
+			DeferredResources: []*DeferredResourceInstanceChangeSrc{
+				{
+					DeferredReason: providers.DeferredReasonProviderConfigUnknown,
+					ChangeSrc:      &ResourceInstanceChangeSrc{Action: Create},
+				},
+			},
+		}
+
+		diags := plan.CheckResourceCreateBudget(1)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+	})
+
+	t.Run("exceeded budget reports the deferred count", func(t *testing.T) {
+		plan := &Plan{
+			Changes: &ChangesSrc{
+				Resources: []*ResourceInstanceChangeSrc{
+					{Action: Create},
+					{Action: Create},
+				},
+			},
+			DeferredResources: []*DeferredResourceInstanceChangeSrc{
+				{
+					DeferredReason: providers.DeferredReasonProviderConfigUnknown,
+					ChangeSrc:      &ResourceInstanceChangeSrc{Action: Create},
+				},
+			},
+		}
+
+		diags := plan.CheckResourceCreateBudget(1)
+		if !diags.HasErrors() {
+			t.Fatal("expected an error, but there was none")
+		}
+		if !strings.Contains(diags.Err().Error(), "1 additional resource instance(s) are deferred") {
+			t.Fatalf("expected the error to mention the deferred count, got: %s", diags.Err())
+		}
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// ApplyConfirmer is an optional capability interface that a provider may
+// implement in addition to Interface. Providers that manage high-risk
+// resources can use it to have Terraform ask for confirmation, via
+// ConfirmApply, immediately before each flagged resource instance's
+// ApplyResourceChange call, giving the provider one last chance to refuse an
+// apply it considers unsafe.
+//
+// This is deliberately kept separate from Interface, rather than being a
+// method every provider must implement, because very few providers have any
+// use for it. Callers should use a type assertion against a
+// providers.Interface value to discover whether a given provider implements
+// it, and should only call ConfirmApply for resource instances that are
+// specifically flagged as requiring confirmation.
+type ApplyConfirmer interface {
+	// ConfirmApply asks the provider to confirm that it's safe to proceed
+	// with the apply described by the request. A response with no
+	// diagnostics means the apply may proceed; an error diagnostic means the
+	// apply must be aborted for this resource instance.
+	ConfirmApply(ConfirmApplyRequest) ConfirmApplyResponse
+}
+
+type ConfirmApplyRequest struct {
+	// TypeName is the resource type name of the instance about to be
+	// applied.
+	TypeName string
+
+	// PriorState and PlannedState are the same values that will shortly be
+	// passed to ApplyResourceChange, given here so the provider can make its
+	// confirmation decision using the same information it will use to apply
+	// the change.
+	PriorState   cty.Value
+	PlannedState cty.Value
+}
+
+type ConfirmApplyResponse struct {
+	// Diagnostics contains any warnings or errors from the method call. If
+	// this contains errors, the caller must not proceed with the apply this
+	// confirmation request was about.
+	Diagnostics tfdiags.Diagnostics
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+// BatchReader is an optional interface that a provider Interface
+// implementation can also implement in order to serve many ReadResource
+// calls together in a single call, rather than one at a time.
+//
+// This exists primarily so that a caller driving many resource instances
+// against the same provider -- such as the stacks runtime refreshing every
+// instance of a resource with an unknown provider configuration -- can
+// amortize any work that's shared across the whole batch (for example,
+// deciding once whether the requests should be deferred) instead of
+// repeating it once per instance.
+//
+// Callers should type-assert a provider Interface for this interface and
+// fall back to calling ReadResource once per request when it isn't
+// implemented, since BatchReader is an optimization and not part of the
+// required provider contract.
+type BatchReader interface {
+	// ReadResources behaves as if ReadResource were called once for each
+	// element of requests, returning the responses in the same order. It
+	// must produce the same result as the single-call form for every
+	// request; batching must never change the outcome, only how
+	// efficiently it's computed.
+	ReadResources(requests []ReadResourceRequest) []ReadResourceResponse
+}
+
+// BatchFunctionCaller is an optional interface that a provider Interface
+// implementation can also implement in order to evaluate many calls to the
+// same provider function together in a single call, rather than one at a
+// time.
+//
+// This exists for callers that need to evaluate a function once per element
+// of a large collection -- such as Core expanding a function call across a
+// for_each or count -- where per-element calls each carry their own
+// round-trip overhead even though the provider could in principle evaluate
+// them all together.
+//
+// Callers should type-assert a provider Interface for this interface and
+// fall back to calling CallFunction once per call when it isn't
+// implemented, since CallFunctionBatch is an optimization and not part of
+// the required provider contract.
+type BatchFunctionCaller interface {
+	// CallFunctionBatch behaves as if CallFunction were called once for
+	// each element of requests, returning the responses in the same order.
+	// It must produce the same result as the single-call form for every
+	// request; batching must never change the outcome, only how
+	// efficiently it's computed.
+	CallFunctionBatch(requests []CallFunctionRequest) []CallFunctionResponse
+}
+
+// CallFunctionBatch evaluates every request in requests against p, using
+// p's CallFunctionBatch method if p implements BatchFunctionCaller, or
+// falling back to calling p.CallFunction once per request otherwise.
+//
+// This lets a caller that wants to take advantage of batch evaluation when
+// it's available -- such as Core evaluating a provider function once per
+// element of a large collection -- do so without needing to know ahead of
+// time whether the specific provider instance it's talking to supports it.
+// The stacks runtime's unknownProvider stub implements BatchFunctionCaller
+// by handling each request exactly as its CallFunction would, so it still
+// errors (or defers) exactly as it would if this function weren't involved
+// at all.
+func CallFunctionBatch(p Interface, requests []CallFunctionRequest) []CallFunctionResponse {
+	if batch, ok := p.(BatchFunctionCaller); ok {
+		return batch.CallFunctionBatch(requests)
+	}
+	responses := make([]CallFunctionResponse, len(requests))
+	for i, request := range requests {
+		responses[i] = p.CallFunction(request)
+	}
+	return responses
+}
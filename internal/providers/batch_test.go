@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/providers"
+	providersTesting "github.com/hashicorp/terraform/internal/providers/testing"
+)
+
+// batchCallFunctionProvider wraps a MockProvider to also implement
+// providers.BatchFunctionCaller, evaluating every request in one pass
+// rather than delegating to CallFunction per request.
+type batchCallFunctionProvider struct {
+	*providersTesting.MockProvider
+	batchCalls int
+}
+
+func (p *batchCallFunctionProvider) CallFunctionBatch(requests []providers.CallFunctionRequest) []providers.CallFunctionResponse {
+	p.batchCalls++
+	responses := make([]providers.CallFunctionResponse, len(requests))
+	for i, request := range requests {
+		responses[i] = providers.CallFunctionResponse{
+			Result: request.Arguments[0],
+		}
+	}
+	return responses
+}
+
+func TestCallFunctionBatch_UsesProviderBatchImplementation(t *testing.T) {
+	provider := &batchCallFunctionProvider{MockProvider: &providersTesting.MockProvider{}}
+
+	requests := make([]providers.CallFunctionRequest, 3)
+	for i := range requests {
+		requests[i] = providers.CallFunctionRequest{
+			FunctionName: "identity",
+			Arguments:    []cty.Value{cty.StringVal(fmt.Sprintf("value-%d", i))},
+		}
+	}
+
+	responses := providers.CallFunctionBatch(provider, requests)
+	if provider.batchCalls != 1 {
+		t.Fatalf("expected exactly 1 batch call, got %d", provider.batchCalls)
+	}
+	if provider.CallFunctionCalled {
+		t.Fatal("expected CallFunction not to be called when a batch implementation is available")
+	}
+	if len(responses) != len(requests) {
+		t.Fatalf("expected %d responses, got %d", len(requests), len(responses))
+	}
+	for i, resp := range responses {
+		if !resp.Result.RawEquals(requests[i].Arguments[0]) {
+			t.Fatalf("response %d: expected %#v, got %#v", i, requests[i].Arguments[0], resp.Result)
+		}
+	}
+}
+
+func TestCallFunctionBatch_FallsBackToPerCallCalls(t *testing.T) {
+	var calls []providers.CallFunctionRequest
+	provider := &providersTesting.MockProvider{
+		CallFunctionFn: func(req providers.CallFunctionRequest) providers.CallFunctionResponse {
+			calls = append(calls, req)
+			return providers.CallFunctionResponse{
+				Result: req.Arguments[0],
+			}
+		},
+	}
+
+	requests := make([]providers.CallFunctionRequest, 3)
+	for i := range requests {
+		requests[i] = providers.CallFunctionRequest{
+			FunctionName: "identity",
+			Arguments:    []cty.Value{cty.StringVal(fmt.Sprintf("value-%d", i))},
+		}
+	}
+
+	responses := providers.CallFunctionBatch(provider, requests)
+	if len(calls) != len(requests) {
+		t.Fatalf("expected %d individual CallFunction calls, got %d", len(requests), len(calls))
+	}
+	if len(responses) != len(requests) {
+		t.Fatalf("expected %d responses, got %d", len(requests), len(responses))
+	}
+	for i, resp := range responses {
+		if !resp.Result.RawEquals(requests[i].Arguments[0]) {
+			t.Fatalf("response %d: expected %#v, got %#v", i, requests[i].Arguments[0], resp.Result)
+		}
+	}
+}
+
+// TestCallFunctionBatch_FallsBackForErroringProvider confirms that a
+// provider which always errors on CallFunction -- as the stacks runtime's
+// unknownProvider stub does -- keeps erroring once per call when it's
+// driven through CallFunctionBatch, since it doesn't implement
+// BatchFunctionCaller.
+func TestCallFunctionBatch_FallsBackForErroringProvider(t *testing.T) {
+	provider := &providersTesting.MockProvider{
+		CallFunctionFn: func(req providers.CallFunctionRequest) providers.CallFunctionResponse {
+			return providers.CallFunctionResponse{
+				Err: fmt.Errorf("CallFunction shouldn't be called on an unknown provider"),
+			}
+		},
+	}
+
+	requests := []providers.CallFunctionRequest{
+		{FunctionName: "greet"},
+		{FunctionName: "greet"},
+	}
+
+	responses := providers.CallFunctionBatch(provider, requests)
+	if len(responses) != len(requests) {
+		t.Fatalf("expected %d responses, got %d", len(requests), len(responses))
+	}
+	for i, resp := range responses {
+		if resp.Err == nil {
+			t.Fatalf("response %d: expected an error, got none", i)
+		}
+	}
+}
+
+func BenchmarkCallFunctionPerCall(b *testing.B) {
+	provider := &providersTesting.MockProvider{
+		CallFunctionFn: func(req providers.CallFunctionRequest) providers.CallFunctionResponse {
+			return providers.CallFunctionResponse{
+				Result: req.Arguments[0],
+			}
+		},
+	}
+	request := providers.CallFunctionRequest{
+		FunctionName: "identity",
+		Arguments:    []cty.Value{cty.StringVal("value")},
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := 0; i < 100; i++ {
+			provider.CallFunction(request)
+		}
+	}
+}
+
+func BenchmarkCallFunctionBatch(b *testing.B) {
+	provider := &batchCallFunctionProvider{MockProvider: &providersTesting.MockProvider{}}
+	requests := make([]providers.CallFunctionRequest, 100)
+	for i := range requests {
+		requests[i] = providers.CallFunctionRequest{
+			FunctionName: "identity",
+			Arguments:    []cty.Value{cty.StringVal("value")},
+		}
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		providers.CallFunctionBatch(provider, requests)
+	}
+}
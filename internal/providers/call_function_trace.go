@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/lang/marks"
+)
+
+// FunctionCallTrace describes a single CallFunction invocation, for use with
+// TraceCallFunction. Arguments and Result have any values marked as
+// sensitive replaced with a placeholder, so a trace can be logged or
+// displayed without risk of leaking secrets.
+type FunctionCallTrace struct {
+	FunctionName string
+	Arguments    []cty.Value
+	Result       cty.Value
+	Err          error
+}
+
+// redactedFunctionTraceValue is substituted for any argument or result value
+// that's marked as sensitive when building a FunctionCallTrace.
+var redactedFunctionTraceValue = cty.StringVal("(sensitive value)")
+
+// TraceCallFunction wraps provider so that every call to CallFunction is
+// reported to record, in addition to being passed through to provider
+// unchanged. This is purely an observability aid for debugging provider
+// function usage; it has no effect on the result returned to the caller.
+//
+// Because it's a generic wrapper around any Interface, tracing a provider
+// that's actually one of the stacks runtime's stub providers -- such as the
+// unknownProvider used for a provider configuration whose identity is still
+// unknown -- works the same way as tracing a real provider: the stub's
+// CallFunction always fails with an error, and that error is captured in
+// the trace like any other.
+func TraceCallFunction(provider Interface, record func(FunctionCallTrace)) Interface {
+	return &callTracingProvider{
+		Interface: provider,
+		record:    record,
+	}
+}
+
+type callTracingProvider struct {
+	Interface
+	record func(FunctionCallTrace)
+}
+
+func (p *callTracingProvider) CallFunction(req CallFunctionRequest) CallFunctionResponse {
+	resp := p.Interface.CallFunction(req)
+
+	trace := FunctionCallTrace{
+		FunctionName: req.FunctionName,
+		Arguments:    make([]cty.Value, len(req.Arguments)),
+		Result:       redactSensitiveFunctionValue(resp.Result),
+		Err:          resp.Err,
+	}
+	for i, arg := range req.Arguments {
+		trace.Arguments[i] = redactSensitiveFunctionValue(arg)
+	}
+	p.record(trace)
+
+	return resp
+}
+
+func redactSensitiveFunctionValue(v cty.Value) cty.Value {
+	if v == cty.NilVal {
+		return v
+	}
+	if marks.Contains(v, marks.Sensitive) {
+		return redactedFunctionTraceValue
+	}
+	return v
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/lang/marks"
+	"github.com/hashicorp/terraform/internal/providers"
+	providersTesting "github.com/hashicorp/terraform/internal/providers/testing"
+)
+
+func TestTraceCallFunction(t *testing.T) {
+	t.Run("captures arguments and results, redacting sensitive values", func(t *testing.T) {
+		mockProvider := &providersTesting.MockProvider{
+			CallFunctionFn: func(req providers.CallFunctionRequest) providers.CallFunctionResponse {
+				return providers.CallFunctionResponse{
+					Result: cty.StringVal("top secret").Mark(marks.Sensitive),
+				}
+			},
+		}
+
+		var traces []providers.FunctionCallTrace
+		traced := providers.TraceCallFunction(mockProvider, func(trace providers.FunctionCallTrace) {
+			traces = append(traces, trace)
+		})
+
+		resp := traced.CallFunction(providers.CallFunctionRequest{
+			FunctionName: "greet",
+			Arguments: []cty.Value{
+				cty.StringVal("hello"),
+				cty.StringVal("password").Mark(marks.Sensitive),
+			},
+		})
+
+		if resp.Result.Equals(cty.StringVal("(sensitive value)")).True() {
+			t.Fatal("expected the response returned to the caller to keep its original value, not the redacted trace copy")
+		}
+
+		if len(traces) != 1 {
+			t.Fatalf("expected 1 trace, got %d", len(traces))
+		}
+		got := traces[0]
+		if got.FunctionName != "greet" {
+			t.Fatalf("wrong function name: %s", got.FunctionName)
+		}
+		if len(got.Arguments) != 2 {
+			t.Fatalf("expected 2 arguments, got %d", len(got.Arguments))
+		}
+		if !got.Arguments[0].RawEquals(cty.StringVal("hello")) {
+			t.Fatalf("expected the non-sensitive argument to be preserved, got %#v", got.Arguments[0])
+		}
+		if !got.Arguments[1].RawEquals(cty.StringVal("(sensitive value)")) {
+			t.Fatalf("expected the sensitive argument to be redacted, got %#v", got.Arguments[1])
+		}
+		if !got.Result.RawEquals(cty.StringVal("(sensitive value)")) {
+			t.Fatalf("expected the sensitive result to be redacted, got %#v", got.Result)
+		}
+	})
+
+	t.Run("traces errors from providers that always fail, such as the stacks unknown-provider stub", func(t *testing.T) {
+		wantErr := errors.New("CallFunction shouldn't be called on an unknown provider")
+		mockProvider := &providersTesting.MockProvider{
+			CallFunctionFn: func(req providers.CallFunctionRequest) providers.CallFunctionResponse {
+				return providers.CallFunctionResponse{Err: wantErr}
+			},
+		}
+
+		var traces []providers.FunctionCallTrace
+		traced := providers.TraceCallFunction(mockProvider, func(trace providers.FunctionCallTrace) {
+			traces = append(traces, trace)
+		})
+
+		resp := traced.CallFunction(providers.CallFunctionRequest{FunctionName: "whatever"})
+		if resp.Err != wantErr {
+			t.Fatalf("wrong error returned to the caller: %s", resp.Err)
+		}
+
+		if len(traces) != 1 {
+			t.Fatalf("expected 1 trace, got %d", len(traces))
+		}
+		if traces[0].Err != wantErr {
+			t.Fatalf("expected the error to be captured in the trace, got %#v", traces[0].Err)
+		}
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/providers"
+	providersTesting "github.com/hashicorp/terraform/internal/providers/testing"
+)
+
+func TestConsistencyLevelReachesReadResource(t *testing.T) {
+	var got providers.ConsistencyLevel
+	mockProvider := &providersTesting.MockProvider{
+		ReadResourceFn: func(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+			got = req.ConsistencyLevel
+			return providers.ReadResourceResponse{
+				NewState: req.PriorState,
+			}
+		},
+	}
+
+	mockProvider.ReadResource(providers.ReadResourceRequest{
+		TypeName:         "test_thing",
+		ConsistencyLevel: providers.ConsistencyLevelStrong,
+	})
+
+	if got != providers.ConsistencyLevelStrong {
+		t.Fatalf("wrong consistency level: got %v, want %v", got, providers.ConsistencyLevelStrong)
+	}
+}
+
+func TestConsistencyLevelReachesReadDataSource(t *testing.T) {
+	var got providers.ConsistencyLevel
+	mockProvider := &providersTesting.MockProvider{
+		ReadDataSourceFn: func(req providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
+			got = req.ConsistencyLevel
+			return providers.ReadDataSourceResponse{
+				State: req.Config,
+			}
+		},
+	}
+
+	mockProvider.ReadDataSource(providers.ReadDataSourceRequest{
+		TypeName:         "test_thing",
+		ConsistencyLevel: providers.ConsistencyLevelEventual,
+	})
+
+	if got != providers.ConsistencyLevelEventual {
+		t.Fatalf("wrong consistency level: got %v, want %v", got, providers.ConsistencyLevelEventual)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// ProviderConfigUnknownSummary is the stable Description().Summary of every
+// diagnostic returned by NewProviderConfigUnknownDiagnostic, so that callers
+// can detect and aggregate these diagnostics programmatically without
+// depending on the exact wording of the detail message.
+const ProviderConfigUnknownSummary = "Provider configuration is unknown"
+
+// NewProviderConfigUnknownDiagnostic returns the standard error diagnostic
+// for an operation that can't proceed because the provider configuration it
+// would run against is still unknown, such as when the stacks runtime
+// substitutes an unknownProvider stub for a provider whose configuration or
+// instances aren't known yet.
+//
+// op should be a short verb phrase describing the attempted operation, in a
+// form that reads naturally after "Cannot", such as "read from this data
+// source" or "plan changes for this resource". providerLabel optionally
+// identifies which provider configuration was unknown, such as the string
+// form of its address, and may be empty if that isn't known.
+func NewProviderConfigUnknownDiagnostic(op string, providerLabel string) tfdiags.Diagnostic {
+	var forClause string
+	if providerLabel != "" {
+		forClause = fmt.Sprintf(" for %s", providerLabel)
+	}
+	return tfdiags.AttributeValue(
+		tfdiags.Error,
+		ProviderConfigUnknownSummary,
+		fmt.Sprintf("Cannot %s because its associated provider configuration%s is unknown.", op, forClause),
+		nil, // nil attribute path means the overall configuration block
+	)
+}
+
+// IsProviderConfigUnknownDiagnostic reports whether diag is one returned by
+// NewProviderConfigUnknownDiagnostic, so that higher layers can detect and
+// aggregate unknown-provider failures without needing to match on detail
+// text.
+func IsProviderConfigUnknownDiagnostic(diag tfdiags.Diagnostic) bool {
+	return diag.Description().Summary == ProviderConfigUnknownSummary
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+func TestNewProviderConfigUnknownDiagnostic(t *testing.T) {
+	diag := providers.NewProviderConfigUnknownDiagnostic("read from this data source", "provider.testing.main")
+
+	if got, want := diag.Severity(), tfdiags.Error; got != want {
+		t.Errorf("wrong severity\ngot:  %s\nwant: %s", got, want)
+	}
+
+	desc := diag.Description()
+	if got, want := desc.Summary, providers.ProviderConfigUnknownSummary; got != want {
+		t.Errorf("wrong summary\ngot:  %s\nwant: %s", got, want)
+	}
+	if !strings.Contains(desc.Detail, "read from this data source") {
+		t.Errorf("detail doesn't mention the operation: %s", desc.Detail)
+	}
+	if !strings.Contains(desc.Detail, "provider.testing.main") {
+		t.Errorf("detail doesn't mention the provider label: %s", desc.Detail)
+	}
+}
+
+func TestNewProviderConfigUnknownDiagnosticNoLabel(t *testing.T) {
+	diag := providers.NewProviderConfigUnknownDiagnostic("plan changes for this resource", "")
+
+	desc := diag.Description()
+	if got, want := desc.Summary, providers.ProviderConfigUnknownSummary; got != want {
+		t.Errorf("wrong summary\ngot:  %s\nwant: %s", got, want)
+	}
+	if want := "Cannot plan changes for this resource because its associated provider configuration is unknown."; desc.Detail != want {
+		t.Errorf("wrong detail\ngot:  %s\nwant: %s", desc.Detail, want)
+	}
+}
+
+func TestIsProviderConfigUnknownDiagnostic(t *testing.T) {
+	unknownDiag := providers.NewProviderConfigUnknownDiagnostic("read from this data source", "")
+	if !providers.IsProviderConfigUnknownDiagnostic(unknownDiag) {
+		t.Error("expected IsProviderConfigUnknownDiagnostic to recognize its own diagnostic")
+	}
+
+	otherDiag := tfdiags.Sourceless(tfdiags.Error, "Some other error", "unrelated detail")
+	if providers.IsProviderConfigUnknownDiagnostic(otherDiag) {
+		t.Error("expected IsProviderConfigUnknownDiagnostic to reject an unrelated diagnostic")
+	}
+}
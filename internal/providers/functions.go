@@ -5,6 +5,8 @@ package providers
 
 import (
 	"fmt"
+	"log"
+	"sync"
 
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/function"
@@ -55,7 +57,15 @@ type FunctionParam struct {
 // will not incur a repeated startup cost.
 //
 // The resTable argument is a shared instance of *FunctionResults, used to
-// check the result values from each function call.
+// check the result values from each function call. Because it's shared
+// across the whole operation rather than scoped to a single module, it also
+// doubles as a cross-module result cache: if some other call to the same
+// function with identical arguments already completed successfully earlier
+// in the operation, that result is reused instead of invoking the provider
+// again, whether or not the two calls came from the same module. A call
+// that previously errored, such as one serviced by the stacks runtime's
+// unknownProvider stub (which always errors), is never cached and so always
+// reaches the provider again.
 func (d FunctionDecl) BuildFunction(providerAddr addrs.Provider, name string, resTable *lang.FunctionResults, factory func() (Interface, error)) function.Function {
 
 	var params []function.Parameter
@@ -71,11 +81,23 @@ func (d FunctionDecl) BuildFunction(providerAddr addrs.Provider, name string, re
 		varParam = &cp
 	}
 
+	// warnDeprecatedOnce makes sure that a deprecated function only logs its
+	// deprecation warning the first time it's actually called, rather than
+	// once per call, since a single configuration can easily call the same
+	// function many times.
+	var warnDeprecatedOnce sync.Once
+
 	return function.New(&function.Spec{
 		Type:     function.StaticReturnType(d.ReturnType),
 		Params:   params,
 		VarParam: varParam,
 		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			if d.DeprecationMessage != "" {
+				warnDeprecatedOnce.Do(func() {
+					log.Printf("[WARN] Provider function %q from provider %q is deprecated: %s", name, providerAddr, d.DeprecationMessage)
+				})
+			}
+
 			for i, arg := range args {
 				var param function.Parameter
 				if i < len(params) {
@@ -102,6 +124,12 @@ func (d FunctionDecl) BuildFunction(providerAddr addrs.Provider, name string, re
 				}
 			}
 
+			if resTable != nil {
+				if cached, ok := resTable.LookupCachedResult(providerAddr, name, args); ok {
+					return cached, nil
+				}
+			}
+
 			provider, err := factory()
 			if err != nil {
 				return cty.UnknownVal(retType), fmt.Errorf("failed to launch provider plugin: %s", err)
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers_test
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/lang"
+	"github.com/hashicorp/terraform/internal/providers"
+	providersTesting "github.com/hashicorp/terraform/internal/providers/testing"
+)
+
+func TestFunctionDecl_BuildFunction_deprecationWarning(t *testing.T) {
+	providerAddr := addrs.NewDefaultProvider("test")
+	mockProvider := &providersTesting.MockProvider{
+		CallFunctionResponse: providers.CallFunctionResponse{
+			Result: cty.StringVal("result"),
+		},
+	}
+
+	decl := providers.FunctionDecl{
+		ReturnType:         cty.String,
+		DeprecationMessage: "use new_function instead",
+	}
+
+	fn := decl.BuildFunction(providerAddr, "old_function", lang.NewFunctionResultsTable(nil), func() (providers.Interface, error) {
+		return mockProvider, nil
+	})
+
+	var logBuf bytes.Buffer
+	log.SetOutput(&logBuf)
+	defer log.SetOutput(os.Stderr)
+
+	if _, err := fn.Call(nil); err != nil {
+		t.Fatalf("unexpected error calling function: %s", err)
+	}
+
+	if got := logBuf.String(); !strings.Contains(got, "old_function") || !strings.Contains(got, "use new_function instead") {
+		t.Errorf("expected deprecation warning in log output, got: %s", got)
+	}
+}
+
+func TestFunctionDecl_BuildFunction_cachesAcrossModules(t *testing.T) {
+	providerAddr := addrs.NewDefaultProvider("test")
+
+	calls := 0
+	mockProvider := &providersTesting.MockProvider{
+		CallFunctionFn: func(providers.CallFunctionRequest) providers.CallFunctionResponse {
+			calls++
+			return providers.CallFunctionResponse{
+				Result: cty.StringVal("result"),
+			}
+		},
+	}
+
+	decl := providers.FunctionDecl{
+		Parameters: []providers.FunctionParam{{Name: "input", Type: cty.String}},
+		ReturnType: cty.String,
+	}
+
+	// Build the function twice, as two different modules composing the same
+	// provider function would each get their own function.Function value,
+	// but sharing the same *lang.FunctionResults for the operation.
+	resTable := lang.NewFunctionResultsTable(nil)
+	fnInModuleA := decl.BuildFunction(providerAddr, "greet", resTable, func() (providers.Interface, error) {
+		return mockProvider, nil
+	})
+	fnInModuleB := decl.BuildFunction(providerAddr, "greet", resTable, func() (providers.Interface, error) {
+		return mockProvider, nil
+	})
+
+	if _, err := fnInModuleA.Call([]cty.Value{cty.StringVal("world")}); err != nil {
+		t.Fatalf("unexpected error calling function in module A: %s", err)
+	}
+	if _, err := fnInModuleB.Call([]cty.Value{cty.StringVal("world")}); err != nil {
+		t.Fatalf("unexpected error calling function in module B: %s", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("expected the provider to be called once, but it was called %d times", calls)
+	}
+}
+
+func TestFunctionDecl_BuildFunction_erroredCallsAreNotCached(t *testing.T) {
+	providerAddr := addrs.NewDefaultProvider("test")
+
+	calls := 0
+	mockProvider := &providersTesting.MockProvider{
+		CallFunctionFn: func(providers.CallFunctionRequest) providers.CallFunctionResponse {
+			calls++
+			// This stands in for the stacks runtime's unknownProvider stub,
+			// whose CallFunction always errors rather than returning a
+			// result, so its calls must never be cached.
+			return providers.CallFunctionResponse{
+				Err: fmt.Errorf("CallFunction shouldn't be called on an unknown provider"),
+			}
+		},
+	}
+
+	decl := providers.FunctionDecl{
+		Parameters: []providers.FunctionParam{{Name: "input", Type: cty.String}},
+		ReturnType: cty.String,
+	}
+
+	resTable := lang.NewFunctionResultsTable(nil)
+	fn := decl.BuildFunction(providerAddr, "greet", resTable, func() (providers.Interface, error) {
+		return mockProvider, nil
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := fn.Call([]cty.Value{cty.StringVal("world")}); err == nil {
+			t.Fatalf("expected an error on call %d, got none", i)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("expected the provider to be called for every invocation, but it was called %d times", calls)
+	}
+}
+
+func TestFunctionDecl_BuildFunction_noDeprecationWarning(t *testing.T) {
+	providerAddr := addrs.NewDefaultProvider("test")
+	mockProvider := &providersTesting.MockProvider{
+		CallFunctionResponse: providers.CallFunctionResponse{
+			Result: cty.StringVal("result"),
+		},
+	}
+
+	decl := providers.FunctionDecl{
+		ReturnType: cty.String,
+	}
+
+	fn := decl.BuildFunction(providerAddr, "current_function", lang.NewFunctionResultsTable(nil), func() (providers.Interface, error) {
+		return mockProvider, nil
+	})
+
+	var logBuf bytes.Buffer
+	log.SetOutput(&logBuf)
+	defer log.SetOutput(os.Stderr)
+
+	if _, err := fn.Call(nil); err != nil {
+		t.Fatalf("unexpected error calling function: %s", err)
+	}
+
+	if got := logBuf.String(); strings.Contains(got, "deprecated") {
+		t.Errorf("expected no deprecation warning in log output, got: %s", got)
+	}
+}
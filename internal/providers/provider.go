@@ -268,6 +268,21 @@ type Schema struct {
 
 	IdentityVersion int64
 	Identity        *configschema.Object
+
+	// ImportIDFormat, if non-empty, is a regular expression that import IDs
+	// for this resource type must match. When set, Core validates a
+	// string-based import ID against this pattern before calling
+	// ImportResourceState, producing a diagnostic for IDs that don't match
+	// instead of letting the provider reject them after a round trip.
+	ImportIDFormat string
+
+	// ImportIDExamples, if non-empty, is a list of example import ID strings
+	// for this resource type, intended to help operators discover the
+	// correct import syntax without having to consult the provider's
+	// documentation. Core doesn't validate these in any way; they're purely
+	// illustrative and are surfaced as-is by commands such as
+	// "terraform providers schema -json".
+	ImportIDExamples []string
 }
 
 // ServerCapabilities allows providers to communicate extra information
@@ -293,6 +308,20 @@ type ServerCapabilities struct {
 	// state for a resource instance, and return the subset of the state which
 	// can be used as configuration.
 	GenerateResourceConfig bool
+
+	// DeferralSupported indicates that the provider is prepared to receive
+	// requests with ClientCapabilities.DeferralAllowed set and to return a
+	// Deferred result from them when appropriate.
+	//
+	// This field isn't yet backed by a real field on the wire protocol
+	// message it's derived from, so in practice it's always false except
+	// when set directly by an in-process provider implementation such as a
+	// test double. Callers that need to gate behavior on whether deferral
+	// is actually supported should keep that limitation in mind: treating
+	// the zero value as "unsupported" would also treat every provider that
+	// predates this capability that way, which is not a safe assumption to
+	// bake into default behavior yet.
+	DeferralSupported bool
 }
 
 // ClientCapabilities allows Terraform to publish information regarding
@@ -486,8 +515,33 @@ type ReadResourceRequest struct {
 
 	// CurrentIdentity is the current identity data of the resource.
 	CurrentIdentity cty.Value
+
+	// ConsistencyLevel is a hint describing the read consistency the caller
+	// would prefer for this request. Providers backed by stores that only
+	// support a single consistency level are free to ignore it.
+	ConsistencyLevel ConsistencyLevel
 }
 
+// ConsistencyLevel describes the read consistency that a caller would like
+// a provider to use when servicing a ReadResource or ReadDataSource call.
+type ConsistencyLevel int
+
+const (
+	// ConsistencyLevelUnspecified means the caller has no preference, and
+	// the provider should use whatever its own default behavior is.
+	ConsistencyLevelUnspecified ConsistencyLevel = iota
+
+	// ConsistencyLevelEventual indicates that the caller is willing to
+	// accept an eventually-consistent read, which some providers can
+	// service more cheaply or quickly than a strongly-consistent one.
+	ConsistencyLevelEventual
+
+	// ConsistencyLevelStrong indicates that the caller requires a
+	// strongly-consistent read, even if that is more expensive for the
+	// provider to produce.
+	ConsistencyLevelStrong
+)
+
 // DeferredReason is a string that describes why a resource was deferred.
 // It differs from the protobuf enum in that it adds more cases
 // since it's more widely used to represent the reason for deferral.
@@ -512,6 +566,13 @@ const (
 	// is that the provider configuration was unknown.
 	DeferredReasonProviderConfigUnknown DeferredReason = "provider_config_unknown"
 
+	// DeferredReasonProviderInstancesUnknown is used when the reason for
+	// deferring is that the set of provider instances to use (for example,
+	// because the provider's for_each expression was unknown) was unknown,
+	// as distinct from the configuration of a known provider instance being
+	// unknown.
+	DeferredReasonProviderInstancesUnknown DeferredReason = "provider_instances_unknown"
+
 	// DeferredReasonAbsentPrereq is used when the reason for deferring is that
 	// a required prerequisite resource was absent.
 	DeferredReasonAbsentPrereq DeferredReason = "absent_prereq"
@@ -700,6 +761,20 @@ type ImportResourceStateResponse struct {
 	// Deferred if present signals that the provider was not able to fully
 	// complete this operation and a subsequent run is required.
 	Deferred *Deferred
+
+	// Retryable, if true alongside error Diagnostics, signals that the
+	// failure was transient (for example, a momentary identity-lookup
+	// failure against a remote API) and that retrying the same
+	// ImportResourceState call again, unmodified, has a reasonable chance of
+	// succeeding. Callers are not required to honor this, but those that
+	// implement retry logic should treat it as the signal for whether a
+	// retry is worthwhile.
+	//
+	// The tfplugin5 and tfplugin6 wire protocols have no equivalent field on
+	// ImportResourceState_Response, so GRPCProvider never sets this; it is
+	// only meaningful for providers.Interface implementations that run
+	// in-process, such as providers in tests.
+	Retryable bool
 }
 
 // GenerateResourceConfigRequest contains the most recent state of a resource
@@ -799,6 +874,11 @@ type ReadDataSourceRequest struct {
 
 	// ClientCapabilities contains information about the client's capabilities.
 	ClientCapabilities ClientCapabilities
+
+	// ConsistencyLevel is a hint describing the read consistency the caller
+	// would prefer for this request. Providers backed by stores that only
+	// support a single consistency level are free to ignore it.
+	ConsistencyLevel ConsistencyLevel
 }
 
 type ReadDataSourceResponse struct {
@@ -1005,6 +1085,11 @@ type InvokeActionRequest struct {
 type InvokeActionResponse struct {
 	Events      iter.Seq[InvokeActionEvent]
 	Diagnostics tfdiags.Diagnostics
+
+	// Deferred if present signals that the provider was not able to invoke
+	// this action and a subsequent run is required. When set, Events is
+	// not consulted, since the action was never actually invoked.
+	Deferred *Deferred
 }
 
 type InvokeActionEvent interface {
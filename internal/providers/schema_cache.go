@@ -4,6 +4,7 @@
 package providers
 
 import (
+	"crypto/sha256"
 	"sync"
 
 	"github.com/hashicorp/terraform/internal/addrs"
@@ -13,7 +14,8 @@ import (
 // This will be accessed by both core and the provider clients to ensure that
 // large schemas are stored in a single location.
 var SchemaCache = &schemaCache{
-	m: make(map[addrs.Provider]ProviderSchema),
+	m:      make(map[addrs.Provider]ProviderSchema),
+	byHash: make(map[[sha256.Size]byte]ProviderSchema),
 }
 
 // Global cache for provider schemas
@@ -23,12 +25,24 @@ var SchemaCache = &schemaCache{
 type schemaCache struct {
 	mu sync.Mutex
 	m  map[addrs.Provider]ProviderSchema
+
+	// byHash interns schemas by their content hash, so that two providers
+	// (or two versions of the same provider) whose schemas are identical
+	// share a single copy rather than each keeping their own.
+	byHash map[[sha256.Size]byte]ProviderSchema
 }
 
 func (c *schemaCache) Set(p addrs.Provider, s ProviderSchema) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	hash := s.contentHash()
+	if interned, ok := c.byHash[hash]; ok {
+		s = interned
+	} else {
+		c.byHash[hash] = s
+	}
+
 	c.m[p] = s
 }
 
@@ -39,3 +53,33 @@ func (c *schemaCache) Get(p addrs.Provider) (ProviderSchema, bool) {
 	s, ok := c.m[p]
 	return s, ok
 }
+
+// SetDelta merges delta onto the schema currently cached for p and stores
+// the result, returning the merged schema.
+//
+// It returns false, without changing the cache, if there is no schema
+// already cached for p. A delta can only ever be applied on top of a full
+// schema, so a caller that receives false here must fetch and store a full
+// schema for p instead (with Set) before it can use deltas for that
+// provider.
+func (c *schemaCache) SetDelta(p addrs.Provider, delta SchemaDelta) (ProviderSchema, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	base, ok := c.m[p]
+	if !ok {
+		return ProviderSchema{}, false
+	}
+
+	merged := base.ApplyDelta(delta)
+
+	hash := merged.contentHash()
+	if interned, ok := c.byHash[hash]; ok {
+		merged = interned
+	} else {
+		c.byHash[hash] = merged
+	}
+
+	c.m[p] = merged
+	return merged, true
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"crypto/sha256"
+	"reflect"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+)
+
+func TestSchemaCache_sharesIdenticalSchemas(t *testing.T) {
+	c := &schemaCache{
+		m:      make(map[addrs.Provider]ProviderSchema),
+		byHash: make(map[[sha256.Size]byte]ProviderSchema),
+	}
+
+	newSchema := func() ProviderSchema {
+		// Two separately-constructed but content-identical schemas, as if
+		// decoded independently from two different provider versions.
+		return ProviderSchema{
+			ResourceTypes: map[string]Schema{
+				"test_thing": {
+					Body: &configschema.Block{
+						Attributes: map[string]*configschema.Attribute{
+							"id": {Type: cty.String, Computed: true},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	providerA := addrs.NewDefaultProvider("a")
+	providerB := addrs.NewDefaultProvider("b")
+
+	c.Set(providerA, newSchema())
+	c.Set(providerB, newSchema())
+
+	if got, want := len(c.byHash), 1; got != want {
+		t.Fatalf("wrong number of interned schemas: got %d, want %d", got, want)
+	}
+
+	schemaA, _ := c.Get(providerA)
+	schemaB, _ := c.Get(providerB)
+	if got, want := reflect.ValueOf(schemaA.ResourceTypes).Pointer(), reflect.ValueOf(schemaB.ResourceTypes).Pointer(); got != want {
+		t.Fatal("identical schemas were not interned to the same underlying map")
+	}
+}
+
+func TestSchemaCache_distinctSchemasNotShared(t *testing.T) {
+	c := &schemaCache{
+		m:      make(map[addrs.Provider]ProviderSchema),
+		byHash: make(map[[sha256.Size]byte]ProviderSchema),
+	}
+
+	providerA := addrs.NewDefaultProvider("a")
+	providerB := addrs.NewDefaultProvider("b")
+
+	c.Set(providerA, ProviderSchema{
+		ResourceTypes: map[string]Schema{
+			"test_thing": {Body: &configschema.Block{}},
+		},
+	})
+	c.Set(providerB, ProviderSchema{
+		ResourceTypes: map[string]Schema{
+			"test_other_thing": {Body: &configschema.Block{}},
+		},
+	})
+
+	if got, want := len(c.byHash), 2; got != want {
+		t.Fatalf("wrong number of interned schemas: got %d, want %d", got, want)
+	}
+}
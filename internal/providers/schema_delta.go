@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+// SchemaDelta describes a partial update to a previously-fetched
+// ProviderSchema: a provider that supports reporting deltas can send one of
+// these instead of a full GetProviderSchemaResponse when only a handful of
+// its resource types or data sources have changed since the last request,
+// avoiding the cost of re-sending and re-decoding its entire schema.
+//
+// A SchemaDelta only ever describes resource type and data source schemas,
+// since those are the object kinds whose schemas are large enough, and
+// numerous enough, for a delta to be worth the complexity. Other schema
+// fields (provider config, provider meta, functions, etc) are always
+// re-sent in full when they change.
+type SchemaDelta struct {
+	// ChangedResourceTypes and ChangedDataSources contain the new schema for
+	// each resource type or data source that has changed since the schema
+	// this delta applies to was fetched.
+	ChangedResourceTypes map[string]Schema
+	ChangedDataSources   map[string]Schema
+
+	// RemovedResourceTypes and RemovedDataSources name resource types and
+	// data sources that existed in the prior schema but no longer exist in
+	// the current provider version.
+	RemovedResourceTypes []string
+	RemovedDataSources   []string
+}
+
+// ApplyDelta produces a new ProviderSchema by merging delta into ss. ss
+// itself is not modified.
+//
+// The result shares unchanged entries with ss, so callers must not mutate
+// the returned schema's maps in place.
+func (ss ProviderSchema) ApplyDelta(delta SchemaDelta) ProviderSchema {
+	ret := ss
+
+	ret.ResourceTypes = mergeSchemaDelta(ss.ResourceTypes, delta.ChangedResourceTypes, delta.RemovedResourceTypes)
+	ret.DataSources = mergeSchemaDelta(ss.DataSources, delta.ChangedDataSources, delta.RemovedDataSources)
+
+	return ret
+}
+
+func mergeSchemaDelta(base, changed map[string]Schema, removed []string) map[string]Schema {
+	ret := make(map[string]Schema, len(base)+len(changed))
+	for k, v := range base {
+		ret[k] = v
+	}
+	for _, k := range removed {
+		delete(ret, k)
+	}
+	for k, v := range changed {
+		ret[k] = v
+	}
+	return ret
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+)
+
+func TestProviderSchema_ApplyDelta(t *testing.T) {
+	base := ProviderSchema{
+		ResourceTypes: map[string]Schema{
+			"test_thing":       {Body: &configschema.Block{}},
+			"test_other_thing": {Body: &configschema.Block{}},
+		},
+		DataSources: map[string]Schema{
+			"test_data": {Body: &configschema.Block{}},
+		},
+	}
+
+	changedThing := Schema{Body: &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"new_attr": {Type: cty.String, Optional: true},
+		},
+	}}
+
+	merged := base.ApplyDelta(SchemaDelta{
+		ChangedResourceTypes: map[string]Schema{
+			"test_thing": changedThing,
+		},
+		RemovedResourceTypes: []string{"test_other_thing"},
+	})
+
+	if _, ok := merged.ResourceTypes["test_other_thing"]; ok {
+		t.Fatal("test_other_thing should have been removed")
+	}
+	if got := merged.ResourceTypes["test_thing"]; got.Body.Attributes["new_attr"] == nil {
+		t.Fatal("test_thing was not updated with the delta")
+	}
+	if _, ok := merged.DataSources["test_data"]; !ok {
+		t.Fatal("unrelated data source should have been preserved")
+	}
+
+	// The original schema must be untouched.
+	if _, ok := base.ResourceTypes["test_other_thing"]; !ok {
+		t.Fatal("ApplyDelta mutated the base schema")
+	}
+}
+
+func TestSchemaCache_SetDelta(t *testing.T) {
+	c := &schemaCache{
+		m:      make(map[addrs.Provider]ProviderSchema),
+		byHash: make(map[[sha256.Size]byte]ProviderSchema),
+	}
+	provider := addrs.NewDefaultProvider("test")
+
+	// No base schema cached yet, so the delta can't be applied.
+	if _, ok := c.SetDelta(provider, SchemaDelta{}); ok {
+		t.Fatal("expected SetDelta to report fallback-required with no cached base schema")
+	}
+
+	c.Set(provider, ProviderSchema{
+		ResourceTypes: map[string]Schema{
+			"test_thing": {Body: &configschema.Block{}},
+		},
+	})
+
+	merged, ok := c.SetDelta(provider, SchemaDelta{
+		ChangedResourceTypes: map[string]Schema{
+			"test_new_thing": {Body: &configschema.Block{}},
+		},
+	})
+	if !ok {
+		t.Fatal("expected SetDelta to succeed once a base schema is cached")
+	}
+	if _, ok := merged.ResourceTypes["test_new_thing"]; !ok {
+		t.Fatal("delta was not merged into the cached schema")
+	}
+
+	got, _ := c.Get(provider)
+	if _, ok := got.ResourceTypes["test_new_thing"]; !ok {
+		t.Fatal("merged schema was not stored in the cache")
+	}
+}
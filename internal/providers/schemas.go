@@ -4,6 +4,10 @@
 package providers
 
 import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
 	"github.com/hashicorp/terraform/internal/addrs"
 )
 
@@ -12,6 +16,50 @@ import (
 // provider schemas should use this type.
 type ProviderSchema = GetProviderSchemaResponse
 
+// contentHash returns a digest of the schema content, excluding the
+// Diagnostics and ServerCapabilities fields which don't describe the shape
+// of the provider's configurable objects. Two ProviderSchema values that
+// describe the same objects, even if built independently (e.g. two
+// different provider versions, or two separate plugin processes), hash to
+// the same value, which SchemaCache uses to avoid storing duplicates.
+func (ss ProviderSchema) contentHash() [sha256.Size]byte {
+	content := struct {
+		Provider               Schema
+		ProviderMeta           Schema
+		ResourceTypes          map[string]Schema
+		DataSources            map[string]Schema
+		EphemeralResourceTypes map[string]Schema
+		ListResourceTypes      map[string]Schema
+		Functions              map[string]FunctionDecl
+		StateStores            map[string]Schema
+		Actions                map[string]ActionSchema
+	}{
+		Provider:               ss.Provider,
+		ProviderMeta:           ss.ProviderMeta,
+		ResourceTypes:          ss.ResourceTypes,
+		DataSources:            ss.DataSources,
+		EphemeralResourceTypes: ss.EphemeralResourceTypes,
+		ListResourceTypes:      ss.ListResourceTypes,
+		Functions:              ss.Functions,
+		StateStores:            ss.StateStores,
+		Actions:                ss.Actions,
+	}
+
+	h := sha256.New()
+	// JSON encoding follows pointers (such as Schema.Body) and sorts map
+	// keys, so equal content always encodes the same way.
+	if err := json.NewEncoder(h).Encode(content); err != nil {
+		// A schema that can't be encoded as JSON is hashed including its
+		// pointer addresses, so it's never shared with another schema.
+		h.Reset()
+		fmt.Fprintf(h, "%#v", content)
+	}
+
+	var sum [sha256.Size]byte
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
+
 // SchemaForResourceType attempts to find a schema for the given mode and type.
 // Returns an empty schema if none is available.
 func (ss ProviderSchema) SchemaForResourceType(mode addrs.ResourceMode, typeName string) (schema Schema) {
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package testing
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// FixtureProvider is a providers.Interface that replays a script of
+// recorded responses instead of doing any real work, for testing Core
+// against a previously-captured plan/apply sequence without needing the
+// real provider available.
+//
+// It embeds MockProvider so that it satisfies providers.Interface in full;
+// only the methods that fixtures have been registered for are overridden
+// below. Calling an overridden method with no matching fixture left in its
+// queue is treated as an error: the request is recorded in Unmatched and an
+// error diagnostic is returned to the caller.
+type FixtureProvider struct {
+	*MockProvider
+
+	planResourceChange  map[string][]providers.PlanResourceChangeResponse
+	applyResourceChange map[string][]providers.ApplyResourceChangeResponse
+	readResource        map[string][]providers.ReadResourceResponse
+
+	unmatched []string
+}
+
+// NewFixtureProvider returns a FixtureProvider with no fixtures registered.
+// Use the Expect* methods to queue up responses before running the code
+// under test.
+func NewFixtureProvider() *FixtureProvider {
+	return &FixtureProvider{
+		MockProvider:        &MockProvider{},
+		planResourceChange:  make(map[string][]providers.PlanResourceChangeResponse),
+		applyResourceChange: make(map[string][]providers.ApplyResourceChangeResponse),
+		readResource:        make(map[string][]providers.ReadResourceResponse),
+	}
+}
+
+// ExpectReadResource queues resp to be returned the next time ReadResource
+// is called for the given resource type.
+func (f *FixtureProvider) ExpectReadResource(typeName string, resp providers.ReadResourceResponse) {
+	f.readResource[typeName] = append(f.readResource[typeName], resp)
+}
+
+// ExpectPlanResourceChange queues resp to be returned the next time
+// PlanResourceChange is called for the given resource type.
+func (f *FixtureProvider) ExpectPlanResourceChange(typeName string, resp providers.PlanResourceChangeResponse) {
+	f.planResourceChange[typeName] = append(f.planResourceChange[typeName], resp)
+}
+
+// ExpectApplyResourceChange queues resp to be returned the next time
+// ApplyResourceChange is called for the given resource type.
+func (f *FixtureProvider) ExpectApplyResourceChange(typeName string, resp providers.ApplyResourceChangeResponse) {
+	f.applyResourceChange[typeName] = append(f.applyResourceChange[typeName], resp)
+}
+
+// Unmatched returns a description of each call that had no fixture left to
+// satisfy it, in the order they occurred. An empty result means every call
+// the code under test made was accounted for by a fixture.
+func (f *FixtureProvider) Unmatched() []string {
+	return f.unmatched
+}
+
+func (f *FixtureProvider) ReadResource(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+	queue := f.readResource[req.TypeName]
+	if len(queue) == 0 {
+		return providers.ReadResourceResponse{
+			Diagnostics: f.recordUnmatched("ReadResource", req.TypeName),
+		}
+	}
+	f.readResource[req.TypeName] = queue[1:]
+	return queue[0]
+}
+
+func (f *FixtureProvider) PlanResourceChange(req providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+	queue := f.planResourceChange[req.TypeName]
+	if len(queue) == 0 {
+		return providers.PlanResourceChangeResponse{
+			Diagnostics: f.recordUnmatched("PlanResourceChange", req.TypeName),
+		}
+	}
+	f.planResourceChange[req.TypeName] = queue[1:]
+	return queue[0]
+}
+
+func (f *FixtureProvider) ApplyResourceChange(req providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+	queue := f.applyResourceChange[req.TypeName]
+	if len(queue) == 0 {
+		return providers.ApplyResourceChangeResponse{
+			Diagnostics: f.recordUnmatched("ApplyResourceChange", req.TypeName),
+		}
+	}
+	f.applyResourceChange[req.TypeName] = queue[1:]
+	return queue[0]
+}
+
+func (f *FixtureProvider) recordUnmatched(method, typeName string) tfdiags.Diagnostics {
+	key := fmt.Sprintf("%s(%s)", method, typeName)
+	f.unmatched = append(f.unmatched, key)
+
+	var diags tfdiags.Diagnostics
+	diags = diags.Append(tfdiags.Sourceless(
+		tfdiags.Error,
+		"Unmatched fixture request",
+		fmt.Sprintf("No recorded fixture is left to satisfy a %s call for %q.", method, typeName),
+	))
+	return diags
+}
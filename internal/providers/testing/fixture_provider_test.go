@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package testing
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/providers"
+)
+
+func TestFixtureProvider_replaysPlanApplySequence(t *testing.T) {
+	p := NewFixtureProvider()
+
+	plannedState := cty.ObjectVal(map[string]cty.Value{
+		"id": cty.UnknownVal(cty.String),
+	})
+	newState := cty.ObjectVal(map[string]cty.Value{
+		"id": cty.StringVal("recorded-id"),
+	})
+
+	p.ExpectPlanResourceChange("test_thing", providers.PlanResourceChangeResponse{
+		PlannedState: plannedState,
+	})
+	p.ExpectApplyResourceChange("test_thing", providers.ApplyResourceChangeResponse{
+		NewState: newState,
+	})
+
+	planResp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
+		TypeName: "test_thing",
+	})
+	if planResp.Diagnostics.HasErrors() {
+		t.Fatalf("unexpected errors: %s", planResp.Diagnostics.Err())
+	}
+	if !planResp.PlannedState.RawEquals(plannedState) {
+		t.Fatalf("wrong planned state\ngot:  %#v\nwant: %#v", planResp.PlannedState, plannedState)
+	}
+
+	applyResp := p.ApplyResourceChange(providers.ApplyResourceChangeRequest{
+		TypeName: "test_thing",
+	})
+	if applyResp.Diagnostics.HasErrors() {
+		t.Fatalf("unexpected errors: %s", applyResp.Diagnostics.Err())
+	}
+	if !applyResp.NewState.RawEquals(newState) {
+		t.Fatalf("wrong new state\ngot:  %#v\nwant: %#v", applyResp.NewState, newState)
+	}
+
+	if got := p.Unmatched(); len(got) != 0 {
+		t.Fatalf("unexpected unmatched requests: %v", got)
+	}
+}
+
+func TestFixtureProvider_unmatchedRequest(t *testing.T) {
+	p := NewFixtureProvider()
+
+	resp := p.ReadResource(providers.ReadResourceRequest{
+		TypeName: "test_thing",
+	})
+	if !resp.Diagnostics.HasErrors() {
+		t.Fatal("expected an error for an unmatched fixture request")
+	}
+
+	want := []string{"ReadResource(test_thing)"}
+	got := p.Unmatched()
+	if len(got) != len(want) || got[0] != want[0] {
+		t.Fatalf("wrong unmatched requests\ngot:  %v\nwant: %v", got, want)
+	}
+}
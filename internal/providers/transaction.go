@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import "github.com/hashicorp/terraform/internal/tfdiags"
+
+// Transactional is an optional capability interface that a provider may
+// implement in addition to Interface. Providers whose backend supports
+// transactions can use it to make a batch of applies for their resources
+// all-or-nothing: Terraform calls BeginTransaction before the first apply,
+// then either CommitTransaction once every apply in the batch has succeeded
+// or RollbackTransaction if any of them failed.
+//
+// This is deliberately kept separate from Interface, rather than being a
+// method every provider must implement, because transaction support is
+// backend-specific and most providers have no use for it. Callers should use
+// a type assertion against a providers.Interface value to discover whether a
+// given provider implements it.
+type Transactional interface {
+	// BeginTransaction starts a new transaction that will cover some number
+	// of subsequent ApplyResourceChange calls. It must be paired with
+	// exactly one following call to CommitTransaction or RollbackTransaction.
+	BeginTransaction(BeginTransactionRequest) BeginTransactionResponse
+
+	// CommitTransaction finalizes the changes made during the transaction
+	// started by the most recent BeginTransaction call.
+	CommitTransaction(CommitTransactionRequest) CommitTransactionResponse
+
+	// RollbackTransaction discards the changes made during the transaction
+	// started by the most recent BeginTransaction call.
+	RollbackTransaction(RollbackTransactionRequest) RollbackTransactionResponse
+}
+
+type BeginTransactionRequest struct {
+}
+
+type BeginTransactionResponse struct {
+	// Diagnostics contains any warnings or errors from the method call. If
+	// this contains errors, the caller should not proceed with any applies
+	// that were meant to be covered by this transaction.
+	Diagnostics tfdiags.Diagnostics
+}
+
+type CommitTransactionRequest struct {
+}
+
+type CommitTransactionResponse struct {
+	// Diagnostics contains any warnings or errors from the method call.
+	Diagnostics tfdiags.Diagnostics
+}
+
+type RollbackTransactionRequest struct {
+}
+
+type RollbackTransactionResponse struct {
+	// Diagnostics contains any warnings or errors from the method call.
+	Diagnostics tfdiags.Diagnostics
+}
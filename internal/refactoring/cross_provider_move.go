@@ -155,6 +155,23 @@ func (move *crossTypeMove) applyCrossTypeMove(stmt *MoveStatement, source, targe
 		return diags
 	}
 
+	// The moved value must conform to the target resource type's schema.
+	// A provider that returns state incompatible with its own schema is
+	// buggy, and we'd rather catch that here with a clear diagnostic than
+	// let it surface later as a confusing error during plan or apply.
+	if _, err := move.targetResourceSchema.Body.CoerceValue(resp.TargetState); err != nil {
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Provider returned invalid value",
+			Detail: fmt.Sprintf(
+				"The provider %q returned a value during an across type move operation to %s that does not conform to the target resource type's schema: %s. This is a bug in the provider, which should be reported in the provider's own issue tracker.",
+				move.targetProviderAddr, target, err,
+			),
+			Subject: stmt.DeclRange.ToHCL().Ptr(),
+		})
+		return diags
+	}
+
 	// Providers are supposed to return null values for all write-only attributes
 	writeOnlyDiags := ephemeral.ValidateWriteOnlyAttributes(
 		"Provider returned invalid value",
@@ -279,6 +279,7 @@ type testResourceInstance struct {
 	renewed       int
 	notifyRenew   chan string
 	closed        bool
+	closeCount    int
 }
 
 func (r *testResourceInstance) Renew(ctx context.Context, req providers.EphemeralRenew) (*providers.EphemeralRenew, tfdiags.Diagnostics) {
@@ -301,5 +302,56 @@ func (r *testResourceInstance) Close(ctx context.Context) tfdiags.Diagnostics {
 	r.Lock()
 	defer r.Unlock()
 	r.closed = true
+	r.closeCount++
 	return nil
 }
+
+// TestResourcesSingleOpenMultipleReferences verifies that an ephemeral
+// resource instance is only opened once no matter how many times its value
+// is referenced within an operation, and that it's only closed once at the
+// end, even if multiple expressions read its cached value.
+func TestResourcesSingleOpenMultipleReferences(t *testing.T) {
+	resources := NewResources()
+
+	addr := addrs.ResourceInstance{
+		Resource: addrs.Resource{
+			Mode: addrs.EphemeralResourceMode,
+			Type: "test",
+			Name: "a",
+		},
+		Key: addrs.NoKey,
+	}.Absolute(addrs.RootModuleInstance)
+
+	ctx := context.Background()
+	impl := &testResourceInstance{}
+
+	want := cty.ObjectVal(map[string]cty.Value{
+		"test": cty.StringVal("ephemeral.test.a"),
+	})
+	resources.RegisterInstance(ctx, addr, ResourceInstanceRegistration{
+		Value: want,
+		Impl:  impl,
+	})
+
+	// Simulate many references to the same ephemeral value within a single
+	// operation. Each reference should read the cached value rather than
+	// causing the resource to be opened again.
+	for i := 0; i < 5; i++ {
+		got, live := resources.InstanceValue(addr)
+		if !live {
+			t.Fatalf("%s should be live", addr)
+		}
+		if !want.RawEquals(got) {
+			t.Fatalf("wanted: %#v\ngot: %#v\n", want, got)
+		}
+	}
+
+	diags := resources.CloseInstances(ctx, addr.ConfigResource())
+	if diags.HasErrors() {
+		t.Fatal(diags.ErrWithWarnings())
+	}
+
+	if impl.closeCount != 1 {
+		t.Fatalf("expected exactly one close, got %d", impl.closeCount)
+	}
+}
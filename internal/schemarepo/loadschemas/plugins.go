@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/terraform/internal/addrs"
 	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/logging"
 	"github.com/hashicorp/terraform/internal/providers"
 	"github.com/hashicorp/terraform/internal/provisioners"
 )
@@ -60,7 +61,25 @@ func (cp *Plugins) NewProviderInstance(addr addrs.Provider) (providers.Interface
 		return nil, fmt.Errorf("unavailable provider %q", addr.String())
 	}
 
-	return f()
+	p, err := f()
+	if err != nil {
+		return nil, err
+	}
+
+	if logging.IsDebugOrHigher() {
+		// Tracing every CallFunction invocation is only useful when
+		// debugging provider function behavior, so we only pay for the
+		// wrapping when the log level asks for it.
+		p = providers.TraceCallFunction(p, func(trace providers.FunctionCallTrace) {
+			if trace.Err != nil {
+				log.Printf("[DEBUG] %s: CallFunction %q failed: %s", addr, trace.FunctionName, trace.Err)
+				return
+			}
+			log.Printf("[DEBUG] %s: CallFunction %q returned %#v", addr, trace.FunctionName, trace.Result)
+		})
+	}
+
+	return p, nil
 }
 
 // ProvisionerFactories returns a map of all of the registered provisioner
@@ -84,6 +84,18 @@ func (tc TestContext) Validate(t *testing.T, ctx context.Context, cycle TestCycl
 	validateDiags(t, cycle.wantValidateDiags, gotDiags)
 }
 
+func (tc TestContext) ValidateOffline(t *testing.T, ctx context.Context, cycle TestCycle) {
+	t.Helper()
+
+	gotDiags := ValidateOffline(ctx, &ValidateRequest{
+		Config:             tc.config,
+		ProviderFactories:  tc.providers,
+		DependencyLocks:    tc.dependencyLocks,
+		ExperimentsAllowed: true,
+	})
+	validateDiags(t, cycle.wantValidateDiags, gotDiags)
+}
+
 func (tc TestContext) Plan(t *testing.T, ctx context.Context, state *stackstate.State, cycle TestCycle) *stackplan.Plan {
 	request := PlanRequest{
 		PlanMode:  cycle.planMode,
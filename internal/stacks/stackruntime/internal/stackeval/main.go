@@ -83,12 +83,13 @@ type Main struct {
 
 	// The remaining fields memoize other objects we might create in response
 	// to method calls. Must lock "mu" before interacting with them.
-	mu                      sync.Mutex
-	mainStackConfig         *StackConfig
-	mainStack               *Stack
-	providerTypes           map[addrs.Provider]*ProviderType
-	providerFunctionResults *lang.FunctionResults
-	cleanupFuncs            []func(context.Context) tfdiags.Diagnostics
+	mu                          sync.Mutex
+	mainStackConfig             *StackConfig
+	mainStack                   *Stack
+	providerTypes               map[addrs.Provider]*ProviderType
+	providerFunctionResults     *lang.FunctionResults
+	unknownDataSourceReadCaches map[addrs.Provider]*stubs.DataSourceReadCache
+	cleanupFuncs                []func(context.Context) tfdiags.Diagnostics
 }
 
 type mainValidating struct {
@@ -426,6 +427,26 @@ func (m *Main) ProviderType(addr addrs.Provider) *ProviderType {
 	return m.providerTypes[addr]
 }
 
+// unknownDataSourceReadCache returns the DataSourceReadCache shared by every
+// unknown-provider stub standing in for addr over the lifetime of m,
+// creating it on first use. Reusing the same cache across every evaluation
+// of an unknown provider's data sources lets repeated reads of the same
+// data source type and configuration -- for example, across successive
+// partial plans while addr's configuration remains unknown -- skip
+// recomputing the same fabricated result.
+func (m *Main) unknownDataSourceReadCache(addr addrs.Provider) *stubs.DataSourceReadCache {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.unknownDataSourceReadCaches == nil {
+		m.unknownDataSourceReadCaches = make(map[addrs.Provider]*stubs.DataSourceReadCache)
+	}
+	if m.unknownDataSourceReadCaches[addr] == nil {
+		m.unknownDataSourceReadCaches[addr] = stubs.NewDataSourceReadCache()
+	}
+	return m.unknownDataSourceReadCaches[addr]
+}
+
 func (m *Main) ProviderRefTypes() map[addrs.Provider]cty.Type {
 	return m.config.ProviderRefTypes
 }
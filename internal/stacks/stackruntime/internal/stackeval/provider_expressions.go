@@ -363,16 +363,13 @@ func evalProviderValue(ctx context.Context, sourceAddr addrs.RootProviderConfig,
 	// returned a concrete value while we may have got unknown during the
 	// static analysis.
 	if v.IsNull() {
-		diags = diags.Append(&hcl.Diagnostic{
-			Severity: hcl.DiagError,
-			Summary:  errSummary,
-			Detail: fmt.Sprintf(
-				"The provider configuration slot %s is required, but this definition returned null.",
-				componentAddr.StringCompact(),
-			),
-			Subject: result.Expression.Range().Ptr(),
-		})
-		return ret, false, diags
+		// A null result means the author wrote a conditional provider
+		// configuration (typically a ternary keyed on a variable) and chose
+		// not to configure this provider for this instance. We treat that
+		// the same as an unknown result: the component falls back to the
+		// unknownProvider stub, and anything depending on it is deferred
+		// until a later round gives it a real configuration.
+		return ret, true, diags
 	}
 	if !v.IsKnown() {
 		return ret, true, diags
@@ -511,7 +508,17 @@ func configuredProviderClients(ctx context.Context, main *Main, known map[addrs.
 		if err != nil {
 			continue
 		}
-		providerInsts[calleeAddr] = stubs.UnknownProvider(client)
+		// We use the function-deferring variant of the unknown provider stub
+		// here because provider-contributed functions are commonly used
+		// directly in component input expressions. Erroring on every such
+		// call, as the stub does by default, would make it impossible to
+		// evaluate expressions that call a provider function with an
+		// unknown provider configuration, even though the surrounding
+		// language has no trouble treating the result as simply unknown.
+		providerInsts[calleeAddr] = stubs.UnknownProviderDeferringFunctions(
+			client, calleeAddr.String(),
+			stubs.WithDataSourceReadCache(main.unknownDataSourceReadCache(provider)),
+		)
 	}
 	return providerInsts
 }
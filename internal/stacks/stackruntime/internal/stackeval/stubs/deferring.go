@@ -0,0 +1,289 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package stubs
+
+import (
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// deferringProvider is a stub provider for testing deferral-consuming code
+// paths deterministically. Unlike unknownProvider, which defers because of
+// a specific missing piece of information (an unknown provider
+// configuration or its instances), deferringProvider exists purely to make
+// every deferral-capable operation defer on demand, with no other stub
+// behavior to account for.
+type deferringProvider struct {
+	reason  providers.DeferredReason
+	skipped map[string]bool
+}
+
+var _ providers.Interface = (*deferringProvider)(nil)
+
+// DeferringProviderOption customizes a stub constructed by DeferringProvider.
+type DeferringProviderOption func(*deferringProvider)
+
+// WithDeferringReason overrides the providers.DeferredReason reported by the
+// resulting stub. It defaults to providers.DeferredReasonProviderConfigUnknown,
+// matching unknownProvider's default.
+func WithDeferringReason(reason providers.DeferredReason) DeferringProviderOption {
+	return func(p *deferringProvider) {
+		p.reason = reason
+	}
+}
+
+// WithoutDeferringFor excludes the named providers.Interface methods (for
+// example "ReadResource") from deferring, so a test can exercise a mix of
+// deferred and non-deferred operations against the same stub. An excluded
+// method returns an ordinary, successful-looking response instead.
+func WithoutDeferringFor(methods ...string) DeferringProviderOption {
+	return func(p *deferringProvider) {
+		for _, method := range methods {
+			p.skipped[method] = true
+		}
+	}
+}
+
+// DeferringProvider returns a stub provider whose deferral-capable methods
+// -- ReadResource, PlanResourceChange, ImportResourceState, ReadDataSource,
+// PlanAction, and InvokeAction -- always report a deferral, regardless of
+// what's in the request, unless that particular method was excluded with
+// WithoutDeferringFor.
+func DeferringProvider(opts ...DeferringProviderOption) providers.Interface {
+	p := &deferringProvider{
+		reason:  providers.DeferredReasonProviderConfigUnknown,
+		skipped: make(map[string]bool),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func (p *deferringProvider) deferral() *providers.Deferred {
+	return &providers.Deferred{
+		Reason: p.reason,
+	}
+}
+
+// ReadResource implements providers.Interface.
+func (p *deferringProvider) ReadResource(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+	if p.skipped["ReadResource"] {
+		return providers.ReadResourceResponse{NewState: req.PriorState, Private: req.Private}
+	}
+	return providers.ReadResourceResponse{
+		NewState: req.PriorState,
+		Private:  req.Private,
+		Deferred: p.deferral(),
+	}
+}
+
+// PlanResourceChange implements providers.Interface.
+func (p *deferringProvider) PlanResourceChange(req providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+	if p.skipped["PlanResourceChange"] {
+		return providers.PlanResourceChangeResponse{PlannedState: req.ProposedNewState}
+	}
+	return providers.PlanResourceChangeResponse{
+		PlannedState: req.ProposedNewState,
+		Deferred:     p.deferral(),
+	}
+}
+
+// ImportResourceState implements providers.Interface.
+func (p *deferringProvider) ImportResourceState(req providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
+	if p.skipped["ImportResourceState"] {
+		return providers.ImportResourceStateResponse{}
+	}
+	return providers.ImportResourceStateResponse{
+		Deferred: p.deferral(),
+	}
+}
+
+// ReadDataSource implements providers.Interface.
+func (p *deferringProvider) ReadDataSource(req providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
+	if p.skipped["ReadDataSource"] {
+		return providers.ReadDataSourceResponse{State: req.Config}
+	}
+	return providers.ReadDataSourceResponse{
+		State:    req.Config,
+		Deferred: p.deferral(),
+	}
+}
+
+// PlanAction implements providers.Interface.
+func (p *deferringProvider) PlanAction(providers.PlanActionRequest) providers.PlanActionResponse {
+	if p.skipped["PlanAction"] {
+		return providers.PlanActionResponse{}
+	}
+	return providers.PlanActionResponse{
+		Deferred: p.deferral(),
+	}
+}
+
+// ApplyResourceChange implements providers.Interface.
+func (p *deferringProvider) ApplyResourceChange(providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+	// A deferred plan should never reach apply, so reaching this is always
+	// a bug in the caller rather than something a test should expect to
+	// need to handle gracefully.
+	var diags tfdiags.Diagnostics
+	diags = diags.Append(tfdiags.AttributeValue(
+		tfdiags.Error,
+		"Called ApplyResourceChange on a deferring provider",
+		"Terraform attempted to apply a change that should have been deferred. This is a bug in Terraform - please report this error.",
+		nil,
+	))
+	return providers.ApplyResourceChangeResponse{
+		Diagnostics: diags,
+	}
+}
+
+// GetProviderSchema implements providers.Interface.
+func (p *deferringProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
+	return providers.GetProviderSchemaResponse{}
+}
+
+// GetResourceIdentitySchemas implements providers.Interface.
+func (p *deferringProvider) GetResourceIdentitySchemas() providers.GetResourceIdentitySchemasResponse {
+	return providers.GetResourceIdentitySchemasResponse{}
+}
+
+// ValidateProviderConfig implements providers.Interface.
+func (p *deferringProvider) ValidateProviderConfig(req providers.ValidateProviderConfigRequest) providers.ValidateProviderConfigResponse {
+	return providers.ValidateProviderConfigResponse{PreparedConfig: req.Config}
+}
+
+// ValidateResourceConfig implements providers.Interface.
+func (p *deferringProvider) ValidateResourceConfig(providers.ValidateResourceConfigRequest) providers.ValidateResourceConfigResponse {
+	return providers.ValidateResourceConfigResponse{}
+}
+
+// ValidateDataResourceConfig implements providers.Interface.
+func (p *deferringProvider) ValidateDataResourceConfig(providers.ValidateDataResourceConfigRequest) providers.ValidateDataResourceConfigResponse {
+	return providers.ValidateDataResourceConfigResponse{}
+}
+
+// ValidateEphemeralResourceConfig implements providers.Interface.
+func (p *deferringProvider) ValidateEphemeralResourceConfig(providers.ValidateEphemeralResourceConfigRequest) providers.ValidateEphemeralResourceConfigResponse {
+	return providers.ValidateEphemeralResourceConfigResponse{}
+}
+
+// ValidateListResourceConfig implements providers.Interface.
+func (p *deferringProvider) ValidateListResourceConfig(providers.ValidateListResourceConfigRequest) providers.ValidateListResourceConfigResponse {
+	return providers.ValidateListResourceConfigResponse{}
+}
+
+// UpgradeResourceState implements providers.Interface.
+func (p *deferringProvider) UpgradeResourceState(providers.UpgradeResourceStateRequest) providers.UpgradeResourceStateResponse {
+	panic("not implemented")
+}
+
+// UpgradeResourceIdentity implements providers.Interface.
+func (p *deferringProvider) UpgradeResourceIdentity(providers.UpgradeResourceIdentityRequest) providers.UpgradeResourceIdentityResponse {
+	panic("not implemented")
+}
+
+// ConfigureProvider implements providers.Interface.
+func (p *deferringProvider) ConfigureProvider(providers.ConfigureProviderRequest) providers.ConfigureProviderResponse {
+	panic("can't configure the stub provider")
+}
+
+// Stop implements providers.Interface.
+func (p *deferringProvider) Stop() error {
+	return nil
+}
+
+// Close implements providers.Interface.
+func (p *deferringProvider) Close() error {
+	return nil
+}
+
+// GenerateResourceConfig implements providers.Interface.
+func (p *deferringProvider) GenerateResourceConfig(providers.GenerateResourceConfigRequest) providers.GenerateResourceConfigResponse {
+	panic("not implemented")
+}
+
+// MoveResourceState implements providers.Interface.
+func (p *deferringProvider) MoveResourceState(providers.MoveResourceStateRequest) providers.MoveResourceStateResponse {
+	panic("not implemented")
+}
+
+// OpenEphemeralResource implements providers.Interface.
+func (p *deferringProvider) OpenEphemeralResource(providers.OpenEphemeralResourceRequest) providers.OpenEphemeralResourceResponse {
+	return providers.OpenEphemeralResourceResponse{}
+}
+
+// RenewEphemeralResource implements providers.Interface.
+func (p *deferringProvider) RenewEphemeralResource(providers.RenewEphemeralResourceRequest) providers.RenewEphemeralResourceResponse {
+	return providers.RenewEphemeralResourceResponse{}
+}
+
+// CloseEphemeralResource implements providers.Interface.
+func (p *deferringProvider) CloseEphemeralResource(providers.CloseEphemeralResourceRequest) providers.CloseEphemeralResourceResponse {
+	return providers.CloseEphemeralResourceResponse{}
+}
+
+// CallFunction implements providers.Interface.
+func (p *deferringProvider) CallFunction(providers.CallFunctionRequest) providers.CallFunctionResponse {
+	panic("not implemented")
+}
+
+// ListResource implements providers.Interface.
+func (p *deferringProvider) ListResource(providers.ListResourceRequest) providers.ListResourceResponse {
+	panic("not implemented")
+}
+
+// ValidateStateStoreConfig implements providers.Interface.
+func (p *deferringProvider) ValidateStateStoreConfig(providers.ValidateStateStoreConfigRequest) providers.ValidateStateStoreConfigResponse {
+	return providers.ValidateStateStoreConfigResponse{}
+}
+
+// ConfigureStateStore implements providers.Interface.
+func (p *deferringProvider) ConfigureStateStore(providers.ConfigureStateStoreRequest) providers.ConfigureStateStoreResponse {
+	return providers.ConfigureStateStoreResponse{}
+}
+
+// ReadStateBytes implements providers.Interface.
+func (p *deferringProvider) ReadStateBytes(providers.ReadStateBytesRequest) providers.ReadStateBytesResponse {
+	panic("not implemented")
+}
+
+// WriteStateBytes implements providers.Interface.
+func (p *deferringProvider) WriteStateBytes(providers.WriteStateBytesRequest) providers.WriteStateBytesResponse {
+	panic("not implemented")
+}
+
+// LockState implements providers.Interface.
+func (p *deferringProvider) LockState(providers.LockStateRequest) providers.LockStateResponse {
+	panic("not implemented")
+}
+
+// UnlockState implements providers.Interface.
+func (p *deferringProvider) UnlockState(providers.UnlockStateRequest) providers.UnlockStateResponse {
+	panic("not implemented")
+}
+
+// GetStates implements providers.Interface.
+func (p *deferringProvider) GetStates(providers.GetStatesRequest) providers.GetStatesResponse {
+	panic("not implemented")
+}
+
+// DeleteState implements providers.Interface.
+func (p *deferringProvider) DeleteState(providers.DeleteStateRequest) providers.DeleteStateResponse {
+	panic("not implemented")
+}
+
+// InvokeAction implements providers.Interface.
+func (p *deferringProvider) InvokeAction(providers.InvokeActionRequest) providers.InvokeActionResponse {
+	if p.skipped["InvokeAction"] {
+		return providers.InvokeActionResponse{}
+	}
+	return providers.InvokeActionResponse{
+		Deferred: p.deferral(),
+	}
+}
+
+// ValidateActionConfig implements providers.Interface.
+func (p *deferringProvider) ValidateActionConfig(providers.ValidateActionConfigRequest) providers.ValidateActionConfigResponse {
+	return providers.ValidateActionConfigResponse{}
+}
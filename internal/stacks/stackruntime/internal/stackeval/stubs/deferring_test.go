@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package stubs
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/providers"
+)
+
+func TestDeferringProviderDefersByDefault(t *testing.T) {
+	provider := DeferringProvider()
+
+	readResp := provider.ReadResource(providers.ReadResourceRequest{
+		PriorState: cty.StringVal("existing"),
+	})
+	if readResp.Deferred == nil {
+		t.Fatal("expected ReadResource to defer")
+	}
+	if readResp.Deferred.Reason != providers.DeferredReasonProviderConfigUnknown {
+		t.Fatalf("wrong deferred reason: %s", readResp.Deferred.Reason)
+	}
+
+	planResp := provider.PlanResourceChange(providers.PlanResourceChangeRequest{
+		ProposedNewState: cty.StringVal("proposed"),
+	})
+	if planResp.Deferred == nil {
+		t.Fatal("expected PlanResourceChange to defer")
+	}
+
+	importResp := provider.ImportResourceState(providers.ImportResourceStateRequest{})
+	if importResp.Deferred == nil {
+		t.Fatal("expected ImportResourceState to defer")
+	}
+
+	readDataResp := provider.ReadDataSource(providers.ReadDataSourceRequest{
+		Config: cty.StringVal("config"),
+	})
+	if readDataResp.Deferred == nil {
+		t.Fatal("expected ReadDataSource to defer")
+	}
+
+	planActionResp := provider.PlanAction(providers.PlanActionRequest{})
+	if planActionResp.Deferred == nil {
+		t.Fatal("expected PlanAction to defer")
+	}
+}
+
+func TestDeferringProviderCustomReason(t *testing.T) {
+	provider := DeferringProvider(WithDeferringReason(providers.DeferredReasonInstanceCountUnknown))
+
+	resp := provider.ReadResource(providers.ReadResourceRequest{
+		PriorState: cty.StringVal("existing"),
+	})
+	if resp.Deferred == nil {
+		t.Fatal("expected ReadResource to defer")
+	}
+	if resp.Deferred.Reason != providers.DeferredReasonInstanceCountUnknown {
+		t.Fatalf("wrong deferred reason: %s", resp.Deferred.Reason)
+	}
+}
+
+func TestDeferringProviderWithoutDeferringFor(t *testing.T) {
+	provider := DeferringProvider(WithoutDeferringFor("ReadResource"))
+
+	readResp := provider.ReadResource(providers.ReadResourceRequest{
+		PriorState: cty.StringVal("existing"),
+	})
+	if readResp.Deferred != nil {
+		t.Fatalf("expected ReadResource not to defer, got %#v", readResp.Deferred)
+	}
+	if !readResp.NewState.RawEquals(cty.StringVal("existing")) {
+		t.Fatalf("wrong new state: %#v", readResp.NewState)
+	}
+
+	planResp := provider.PlanResourceChange(providers.PlanResourceChangeRequest{
+		ProposedNewState: cty.StringVal("proposed"),
+	})
+	if planResp.Deferred == nil {
+		t.Fatal("expected PlanResourceChange to still defer, since only ReadResource was excluded")
+	}
+}
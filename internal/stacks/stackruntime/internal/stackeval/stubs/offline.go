@@ -13,7 +13,9 @@ import (
 // configuration.
 //
 // The only functionality that should be called on an offlineProvider are
-// provider function calls and move resource state.
+// provider function calls, move resource state, and validating the provider
+// configuration itself (which a provider can usually do without reaching
+// its remote API, making it safe to run offline, e.g. for CI config checks).
 //
 // For everything else, Stacks should have provided a pre-configured provider
 // that should be used instead.
@@ -37,17 +39,14 @@ func (o *offlineProvider) GetResourceIdentitySchemas() providers.GetResourceIden
 	return o.unconfiguredClient.GetResourceIdentitySchemas()
 }
 
-func (o *offlineProvider) ValidateProviderConfig(_ providers.ValidateProviderConfigRequest) providers.ValidateProviderConfigResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called ValidateProviderConfig on an unconfigured provider",
-		"Cannot validate provider configuration because this provider is not configured. This is a bug in Terraform - please report it.",
-		nil, // nil attribute path means the overall configuration block
-	))
-	return providers.ValidateProviderConfigResponse{
-		Diagnostics: diags,
-	}
+// ValidateProviderConfig delegates to the unconfigured client rather than
+// erroring, since the provider's own config validation logic (required
+// attributes, conflicting attributes, cty type checks, and so on) doesn't
+// depend on the provider actually being configured or reachable over the
+// network. That makes it usable for dry-run config checks, such as in CI,
+// where real provider configuration is undesirable or unavailable.
+func (o *offlineProvider) ValidateProviderConfig(request providers.ValidateProviderConfigRequest) providers.ValidateProviderConfigResponse {
+	return o.unconfiguredClient.ValidateProviderConfig(request)
 }
 
 func (o *offlineProvider) ValidateResourceConfig(_ providers.ValidateResourceConfigRequest) providers.ValidateResourceConfigResponse {
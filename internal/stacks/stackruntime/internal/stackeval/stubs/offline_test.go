@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package stubs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/providers"
+	testing_provider "github.com/hashicorp/terraform/internal/providers/testing"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+func TestOfflineProviderValidateProviderConfig(t *testing.T) {
+	t.Run("delegates to the unconfigured client and reports config errors", func(t *testing.T) {
+		unconfiguredClient := &testing_provider.MockProvider{
+			ValidateProviderConfigFn: func(req providers.ValidateProviderConfigRequest) providers.ValidateProviderConfigResponse {
+				var diags tfdiags.Diagnostics
+				diags = diags.Append(fmt.Errorf("missing required attribute \"region\""))
+				return providers.ValidateProviderConfigResponse{
+					Diagnostics: diags,
+				}
+			},
+		}
+		provider := OfflineProvider(unconfiguredClient)
+
+		resp := provider.ValidateProviderConfig(providers.ValidateProviderConfigRequest{
+			Config: cty.EmptyObjectVal,
+		})
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected the config error from the unconfigured client to be reported")
+		}
+	})
+
+	t.Run("succeeds offline when the config is valid", func(t *testing.T) {
+		unconfiguredClient := &testing_provider.MockProvider{}
+		provider := OfflineProvider(unconfiguredClient)
+
+		resp := provider.ValidateProviderConfig(providers.ValidateProviderConfigRequest{
+			Config: cty.EmptyObjectVal,
+		})
+		if resp.Diagnostics.HasErrors() {
+			t.Fatalf("unexpected errors: %s", resp.Diagnostics.Err())
+		}
+		if !unconfiguredClient.ValidateProviderConfigCalled {
+			t.Fatal("expected the call to be delegated to the unconfigured client")
+		}
+	})
+}
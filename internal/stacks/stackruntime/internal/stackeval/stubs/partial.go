@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package stubs
+
+import (
+	"github.com/hashicorp/terraform/internal/providers"
+)
+
+var _ providers.Interface = (*partialProvider)(nil)
+
+// partialProvider is a stub provider for a provider configuration that is
+// itself known, but whose instance expansion (for example, a for_each whose
+// keys aren't fully known yet) is only partially resolved, so Stacks cannot
+// yet say which concrete provider instance a particular resource instance
+// belongs to.
+//
+// It embeds unknownProvider to inherit the same "defer or error" behavior
+// for every instance-level operation, since not knowing which instance is
+// involved is exactly the same problem as not knowing the provider at all
+// from the point of view of those operations. Provider functions and other
+// operations that are genuinely offline don't depend on instance expansion
+// at all, though, so partialProvider overrides those to delegate straight
+// to the unconfigured client instead of deferring or erroring, letting
+// stack authors keep using provider functions even while some instances are
+// still unresolved.
+type partialProvider struct {
+	*unknownProvider
+}
+
+func PartialProvider(unconfiguredClient providers.Interface) providers.Interface {
+	return &partialProvider{
+		unknownProvider: &unknownProvider{unconfiguredClient: unconfiguredClient},
+	}
+}
+
+func (p *partialProvider) CallFunction(request providers.CallFunctionRequest) providers.CallFunctionResponse {
+	return p.unconfiguredClient.CallFunction(request)
+}
+
+func (p *partialProvider) CallFunctionBatch(requests []providers.CallFunctionRequest) []providers.CallFunctionResponse {
+	return providers.CallFunctionBatch(p.unconfiguredClient, requests)
+}
+
+func (p *partialProvider) MoveResourceState(request providers.MoveResourceStateRequest) providers.MoveResourceStateResponse {
+	return p.unconfiguredClient.MoveResourceState(request)
+}
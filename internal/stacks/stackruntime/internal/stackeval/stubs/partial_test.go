@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package stubs
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/providers"
+	testing_provider "github.com/hashicorp/terraform/internal/providers/testing"
+)
+
+func TestPartialProvider(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{
+		CallFunctionResponse: providers.CallFunctionResponse{
+			Result: cty.StringVal("ok"),
+		},
+	}
+	provider := PartialProvider(unconfiguredClient)
+
+	t.Run("CallFunction succeeds by delegating to the unconfigured client", func(t *testing.T) {
+		resp := provider.CallFunction(providers.CallFunctionRequest{
+			FunctionName: "greet",
+			Arguments:    []cty.Value{cty.StringVal("world")},
+		})
+		if resp.Err != nil {
+			t.Fatalf("unexpected error: %s", resp.Err)
+		}
+		if !resp.Result.RawEquals(cty.StringVal("ok")) {
+			t.Fatalf("wrong result: got %#v, want %#v", resp.Result, cty.StringVal("ok"))
+		}
+		if !unconfiguredClient.CallFunctionCalled {
+			t.Fatal("expected the call to be delegated to the unconfigured client")
+		}
+	})
+
+	t.Run("ApplyResourceChange still errors, since instance expansion is unresolved", func(t *testing.T) {
+		resp := provider.ApplyResourceChange(providers.ApplyResourceChangeRequest{
+			TypeName: "test_thing",
+		})
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected an error, but there was none")
+		}
+	})
+}
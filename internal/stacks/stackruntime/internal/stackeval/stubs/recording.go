@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package stubs
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform/internal/providers"
+)
+
+var _ providers.Interface = (*RecordingUnknownProvider)(nil)
+
+// RecordedCall is a single method call observed by a RecordingUnknownProvider,
+// in the order it was made.
+type RecordedCall struct {
+	// Method is the name of the providers.Interface method that was called,
+	// such as "PlanResourceChange".
+	Method string
+
+	// Request is the request value passed to that method.
+	Request interface{}
+}
+
+// RecordingUnknownProvider wraps an unknownProvider stub and records every
+// method call made against it, so that tests -- and developers debugging why
+// a stack deferred -- can inspect exactly which operations were deferred
+// versus errored without having to step through the stub itself.
+//
+// It's intended for use in tests and behind debug tooling, not as part of
+// the normal planning path: wrap the result of UnknownProvider or
+// UnknownProviderDeferringFunctions with NewRecordingUnknownProvider only
+// when that visibility is actually needed.
+type RecordingUnknownProvider struct {
+	wrapped providers.Interface
+
+	mu    sync.Mutex
+	calls []RecordedCall
+}
+
+// NewRecordingUnknownProvider wraps wrapped -- expected to be the result of
+// UnknownProvider or UnknownProviderDeferringFunctions -- so that every call
+// made through the returned provider is recorded for later inspection via
+// Calls.
+func NewRecordingUnknownProvider(wrapped providers.Interface) *RecordingUnknownProvider {
+	return &RecordingUnknownProvider{wrapped: wrapped}
+}
+
+// Calls returns the calls recorded so far, in the order they were made.
+func (r *RecordingUnknownProvider) Calls() []RecordedCall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ret := make([]RecordedCall, len(r.calls))
+	copy(ret, r.calls)
+	return ret
+}
+
+func (r *RecordingUnknownProvider) record(method string, request interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, RecordedCall{Method: method, Request: request})
+}
+
+func (r *RecordingUnknownProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
+	r.record("GetProviderSchema", nil)
+	return r.wrapped.GetProviderSchema()
+}
+
+func (r *RecordingUnknownProvider) GetResourceIdentitySchemas() providers.GetResourceIdentitySchemasResponse {
+	r.record("GetResourceIdentitySchemas", nil)
+	return r.wrapped.GetResourceIdentitySchemas()
+}
+
+func (r *RecordingUnknownProvider) ValidateProviderConfig(request providers.ValidateProviderConfigRequest) providers.ValidateProviderConfigResponse {
+	r.record("ValidateProviderConfig", request)
+	return r.wrapped.ValidateProviderConfig(request)
+}
+
+func (r *RecordingUnknownProvider) ValidateResourceConfig(request providers.ValidateResourceConfigRequest) providers.ValidateResourceConfigResponse {
+	r.record("ValidateResourceConfig", request)
+	return r.wrapped.ValidateResourceConfig(request)
+}
+
+func (r *RecordingUnknownProvider) ValidateDataResourceConfig(request providers.ValidateDataResourceConfigRequest) providers.ValidateDataResourceConfigResponse {
+	r.record("ValidateDataResourceConfig", request)
+	return r.wrapped.ValidateDataResourceConfig(request)
+}
+
+func (r *RecordingUnknownProvider) ValidateEphemeralResourceConfig(request providers.ValidateEphemeralResourceConfigRequest) providers.ValidateEphemeralResourceConfigResponse {
+	r.record("ValidateEphemeralResourceConfig", request)
+	return r.wrapped.ValidateEphemeralResourceConfig(request)
+}
+
+func (r *RecordingUnknownProvider) ValidateListResourceConfig(request providers.ValidateListResourceConfigRequest) providers.ValidateListResourceConfigResponse {
+	r.record("ValidateListResourceConfig", request)
+	return r.wrapped.ValidateListResourceConfig(request)
+}
+
+func (r *RecordingUnknownProvider) UpgradeResourceState(request providers.UpgradeResourceStateRequest) providers.UpgradeResourceStateResponse {
+	r.record("UpgradeResourceState", request)
+	return r.wrapped.UpgradeResourceState(request)
+}
+
+func (r *RecordingUnknownProvider) UpgradeResourceIdentity(request providers.UpgradeResourceIdentityRequest) providers.UpgradeResourceIdentityResponse {
+	r.record("UpgradeResourceIdentity", request)
+	return r.wrapped.UpgradeResourceIdentity(request)
+}
+
+func (r *RecordingUnknownProvider) ConfigureProvider(request providers.ConfigureProviderRequest) providers.ConfigureProviderResponse {
+	r.record("ConfigureProvider", request)
+	return r.wrapped.ConfigureProvider(request)
+}
+
+func (r *RecordingUnknownProvider) Stop() error {
+	r.record("Stop", nil)
+	return r.wrapped.Stop()
+}
+
+func (r *RecordingUnknownProvider) ReadResource(request providers.ReadResourceRequest) providers.ReadResourceResponse {
+	r.record("ReadResource", request)
+	return r.wrapped.ReadResource(request)
+}
+
+func (r *RecordingUnknownProvider) PlanResourceChange(request providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+	r.record("PlanResourceChange", request)
+	return r.wrapped.PlanResourceChange(request)
+}
+
+func (r *RecordingUnknownProvider) ApplyResourceChange(request providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+	r.record("ApplyResourceChange", request)
+	return r.wrapped.ApplyResourceChange(request)
+}
+
+func (r *RecordingUnknownProvider) ImportResourceState(request providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
+	r.record("ImportResourceState", request)
+	return r.wrapped.ImportResourceState(request)
+}
+
+func (r *RecordingUnknownProvider) GenerateResourceConfig(request providers.GenerateResourceConfigRequest) providers.GenerateResourceConfigResponse {
+	r.record("GenerateResourceConfig", request)
+	return r.wrapped.GenerateResourceConfig(request)
+}
+
+func (r *RecordingUnknownProvider) MoveResourceState(request providers.MoveResourceStateRequest) providers.MoveResourceStateResponse {
+	r.record("MoveResourceState", request)
+	return r.wrapped.MoveResourceState(request)
+}
+
+func (r *RecordingUnknownProvider) ReadDataSource(request providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
+	r.record("ReadDataSource", request)
+	return r.wrapped.ReadDataSource(request)
+}
+
+func (r *RecordingUnknownProvider) OpenEphemeralResource(request providers.OpenEphemeralResourceRequest) providers.OpenEphemeralResourceResponse {
+	r.record("OpenEphemeralResource", request)
+	return r.wrapped.OpenEphemeralResource(request)
+}
+
+func (r *RecordingUnknownProvider) RenewEphemeralResource(request providers.RenewEphemeralResourceRequest) providers.RenewEphemeralResourceResponse {
+	r.record("RenewEphemeralResource", request)
+	return r.wrapped.RenewEphemeralResource(request)
+}
+
+func (r *RecordingUnknownProvider) CloseEphemeralResource(request providers.CloseEphemeralResourceRequest) providers.CloseEphemeralResourceResponse {
+	r.record("CloseEphemeralResource", request)
+	return r.wrapped.CloseEphemeralResource(request)
+}
+
+func (r *RecordingUnknownProvider) CallFunction(request providers.CallFunctionRequest) providers.CallFunctionResponse {
+	r.record("CallFunction", request)
+	return r.wrapped.CallFunction(request)
+}
+
+func (r *RecordingUnknownProvider) ListResource(request providers.ListResourceRequest) providers.ListResourceResponse {
+	r.record("ListResource", request)
+	return r.wrapped.ListResource(request)
+}
+
+func (r *RecordingUnknownProvider) ValidateStateStoreConfig(request providers.ValidateStateStoreConfigRequest) providers.ValidateStateStoreConfigResponse {
+	r.record("ValidateStateStoreConfig", request)
+	return r.wrapped.ValidateStateStoreConfig(request)
+}
+
+func (r *RecordingUnknownProvider) ConfigureStateStore(request providers.ConfigureStateStoreRequest) providers.ConfigureStateStoreResponse {
+	r.record("ConfigureStateStore", request)
+	return r.wrapped.ConfigureStateStore(request)
+}
+
+func (r *RecordingUnknownProvider) ReadStateBytes(request providers.ReadStateBytesRequest) providers.ReadStateBytesResponse {
+	r.record("ReadStateBytes", request)
+	return r.wrapped.ReadStateBytes(request)
+}
+
+func (r *RecordingUnknownProvider) WriteStateBytes(request providers.WriteStateBytesRequest) providers.WriteStateBytesResponse {
+	r.record("WriteStateBytes", request)
+	return r.wrapped.WriteStateBytes(request)
+}
+
+func (r *RecordingUnknownProvider) LockState(request providers.LockStateRequest) providers.LockStateResponse {
+	r.record("LockState", request)
+	return r.wrapped.LockState(request)
+}
+
+func (r *RecordingUnknownProvider) UnlockState(request providers.UnlockStateRequest) providers.UnlockStateResponse {
+	r.record("UnlockState", request)
+	return r.wrapped.UnlockState(request)
+}
+
+func (r *RecordingUnknownProvider) GetStates(request providers.GetStatesRequest) providers.GetStatesResponse {
+	r.record("GetStates", request)
+	return r.wrapped.GetStates(request)
+}
+
+func (r *RecordingUnknownProvider) DeleteState(request providers.DeleteStateRequest) providers.DeleteStateResponse {
+	r.record("DeleteState", request)
+	return r.wrapped.DeleteState(request)
+}
+
+func (r *RecordingUnknownProvider) PlanAction(request providers.PlanActionRequest) providers.PlanActionResponse {
+	r.record("PlanAction", request)
+	return r.wrapped.PlanAction(request)
+}
+
+func (r *RecordingUnknownProvider) InvokeAction(request providers.InvokeActionRequest) providers.InvokeActionResponse {
+	r.record("InvokeAction", request)
+	return r.wrapped.InvokeAction(request)
+}
+
+func (r *RecordingUnknownProvider) ValidateActionConfig(request providers.ValidateActionConfigRequest) providers.ValidateActionConfigResponse {
+	r.record("ValidateActionConfig", request)
+	return r.wrapped.ValidateActionConfig(request)
+}
+
+func (r *RecordingUnknownProvider) Close() error {
+	r.record("Close", nil)
+	return r.wrapped.Close()
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package stubs
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/providers"
+	testing_provider "github.com/hashicorp/terraform/internal/providers/testing"
+)
+
+func TestRecordingUnknownProviderRecordsCalls(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{
+		GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+			ResourceTypes: map[string]providers.Schema{
+				"test_thing": {
+					Body: &configschema.Block{
+						Attributes: map[string]*configschema.Attribute{
+							"id": {Type: cty.String, Computed: true},
+						},
+					},
+				},
+			},
+			DataSources: map[string]providers.Schema{
+				"test_thing": {
+					Body: &configschema.Block{
+						Attributes: map[string]*configschema.Attribute{
+							"id": {Type: cty.String, Computed: true},
+						},
+					},
+				},
+			},
+		},
+	}
+	provider := NewRecordingUnknownProvider(UnknownProvider(unconfiguredClient, ""))
+
+	provider.PlanResourceChange(providers.PlanResourceChangeRequest{
+		TypeName: "test_thing",
+		ProposedNewState: cty.ObjectVal(map[string]cty.Value{
+			"id": cty.NullVal(cty.String),
+		}),
+	})
+	provider.ReadDataSource(providers.ReadDataSourceRequest{
+		TypeName: "test_thing",
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"id": cty.NullVal(cty.String),
+		}),
+	})
+
+	calls := provider.Calls()
+	if got, want := len(calls), 2; got != want {
+		t.Fatalf("wrong number of recorded calls: got %d, want %d", got, want)
+	}
+	if got, want := calls[0].Method, "PlanResourceChange"; got != want {
+		t.Fatalf("wrong first call: got %s, want %s", got, want)
+	}
+	if _, ok := calls[0].Request.(providers.PlanResourceChangeRequest); !ok {
+		t.Fatalf("wrong request type for first call: %#v", calls[0].Request)
+	}
+	if got, want := calls[1].Method, "ReadDataSource"; got != want {
+		t.Fatalf("wrong second call: got %s, want %s", got, want)
+	}
+	if _, ok := calls[1].Request.(providers.ReadDataSourceRequest); !ok {
+		t.Fatalf("wrong request type for second call: %#v", calls[1].Request)
+	}
+}
+
+func TestRecordingUnknownProviderDelegatesBehavior(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{}
+	provider := NewRecordingUnknownProvider(UnknownProvider(unconfiguredClient, ""))
+
+	resp := provider.ReadResource(providers.ReadResourceRequest{
+		TypeName: "test_thing",
+		ClientCapabilities: providers.ClientCapabilities{
+			DeferralAllowed: true,
+		},
+		PriorState: cty.StringVal("existing"),
+	})
+	if resp.Deferred == nil {
+		t.Fatal("expected the read to still be deferred, same as the wrapped unknownProvider")
+	}
+
+	calls := provider.Calls()
+	if len(calls) != 1 || calls[0].Method != "ReadResource" {
+		t.Fatalf("expected ReadResource to be recorded, got %#v", calls)
+	}
+}
@@ -5,43 +5,205 @@ package stubs
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/zclconf/go-cty/cty"
 
 	"github.com/hashicorp/terraform/internal/lang/ephemeral"
+	"github.com/hashicorp/terraform/internal/lang/marks"
 	"github.com/hashicorp/terraform/internal/moduletest/mocking"
 	"github.com/hashicorp/terraform/internal/providers"
 	"github.com/hashicorp/terraform/internal/tfdiags"
 )
 
 var _ providers.Interface = (*unknownProvider)(nil)
+var _ providers.BatchReader = (*unknownProvider)(nil)
+var _ providers.BatchFunctionCaller = (*unknownProvider)(nil)
 
 // unknownProvider is a stub provider that represents a provider that is
 // unknown to the current Terraform configuration. This is used when a reference
 // to a provider is unknown, or the provider itself has unknown instances.
 //
 // An unknownProvider is only returned in the context of a provider that should
-// have been configured by Stacks. This provider should not be configured again,
-// or used for any dedicated offline functionality (such as moving resources and
-// provider functions).
+// have been configured by Stacks. This provider should not be configured
+// again, though some genuinely offline functionality -- such as moving
+// resource state and calling provider functions -- is still delegated
+// through to the unconfigured client.
 type unknownProvider struct {
 	unconfiguredClient providers.Interface
+
+	// label identifies the specific provider configuration (or instance)
+	// this stub stands in for, such as the string form of a
+	// stackaddrs.AbsProviderConfigInstance, so that the diagnostics this
+	// stub returns can say which provider configuration was unknown rather
+	// than just that some unspecified one was. It's passed verbatim to
+	// providers.NewProviderConfigUnknownDiagnostic, and may be empty when
+	// no caller-identifying information is available.
+	label string
+
+	// deferFunctionCalls, when true, causes CallFunction to respond with an
+	// unknown value of the function's declared return type instead of an
+	// error. This is for callers that evaluate provider functions as part
+	// of planning a stack whose provider configuration happens to still be
+	// unknown, where a function call reaching this stub is an expected
+	// consequence of that and not a bug to report.
+	deferFunctionCalls bool
+
+	// deferralReason overrides the providers.DeferredReason reported by
+	// every deferral-producing method. It's empty by default, which deferral
+	// treats as providers.DeferredReasonProviderConfigUnknown. Set it with
+	// WithDeferralReason when this stub stands in for a provider whose
+	// *instances* are unknown (for example, because of an unknown for_each)
+	// rather than one whose configuration is unknown, so that downstream
+	// tooling can distinguish the two cases.
+	deferralReason providers.DeferredReason
+
+	// identitySchemasOnce and identitySchemas memoize the result of the
+	// first call to GetResourceIdentitySchemas for the lifetime of this
+	// stub. Identity schemas can't change partway through an operation, and
+	// a stack with many unknown-provider instances can otherwise end up
+	// asking the unconfigured client for the same schemas over and over.
+	identitySchemasOnce sync.Once
+	identitySchemas     providers.GetResourceIdentitySchemasResponse
+
+	// dataSourceReadCache, if set with WithDataSourceReadCache, lets
+	// ReadDataSource reuse an earlier result for the same data source type
+	// and configuration instead of fabricating the same unknown-valued
+	// result again. It's nil by default, in which case every ReadDataSource
+	// call is computed fresh as before.
+	dataSourceReadCache *DataSourceReadCache
+}
+
+// UnknownProviderOption customizes a stub constructed by UnknownProvider or
+// UnknownProviderDeferringFunctions.
+type UnknownProviderOption func(*unknownProvider)
+
+// WithDeferralReason overrides the providers.DeferredReason reported by
+// every deferral-producing method on the resulting stub. It defaults to
+// providers.DeferredReasonProviderConfigUnknown.
+func WithDeferralReason(reason providers.DeferredReason) UnknownProviderOption {
+	return func(u *unknownProvider) {
+		u.deferralReason = reason
+	}
+}
+
+// WithDataSourceReadCache attaches cache to the resulting stub, so that its
+// ReadDataSource method can reuse a prior result for a data source type and
+// configuration it's already seen during the lifetime of cache instead of
+// fabricating the same unknown-valued result over again. It's unset by
+// default.
+func WithDataSourceReadCache(cache *DataSourceReadCache) UnknownProviderOption {
+	return func(u *unknownProvider) {
+		u.dataSourceReadCache = cache
+	}
 }
 
-func UnknownProvider(unconfiguredClient providers.Interface) providers.Interface {
-	return &unknownProvider{
+func UnknownProvider(unconfiguredClient providers.Interface, label string, opts ...UnknownProviderOption) providers.Interface {
+	u := &unknownProvider{
 		unconfiguredClient: unconfiguredClient,
+		label:              label,
+	}
+	for _, opt := range opts {
+		opt(u)
+	}
+	return u
+}
+
+// UnknownProviderDeferringFunctions is like UnknownProvider, except that
+// CallFunction returns an unknown value of the declared return type instead
+// of erroring, for callers that can tolerate -- and need to tolerate --
+// provider functions being called while the provider configuration is
+// still unknown.
+func UnknownProviderDeferringFunctions(unconfiguredClient providers.Interface, label string, opts ...UnknownProviderOption) providers.Interface {
+	u := &unknownProvider{
+		unconfiguredClient: unconfiguredClient,
+		label:              label,
+		deferFunctionCalls: true,
+	}
+	for _, opt := range opts {
+		opt(u)
+	}
+	return u
+}
+
+// unknownStateForSchema builds the unknown-valued result used by the
+// unknownProvider methods that defer an operation because the provider
+// configuration itself is unknown. It populates schema's computed
+// attributes with unknown values relative to config -- by abusing the
+// mocking package's support for building mock plan values, which isn't its
+// original use case but does exactly what's needed here -- strips any
+// write-only attributes from the result, marks any sensitive attributes as
+// sensitive just as a real provider's response would be treated, and
+// returns u's configured deferral reason alongside it.
+//
+// If config set a non-null value for a write-only attribute, that's
+// reported as a warning diagnostic rather than silently discarded, since it
+// may indicate a mistake on the user's part that they'd otherwise have no
+// way of noticing.
+func (u *unknownProvider) unknownStateForSchema(schema providers.Schema, config cty.Value) (cty.Value, *providers.Deferred, tfdiags.Diagnostics) {
+	val, diags := mocking.PlanComputedValuesForResource(config, nil, schema.Body)
+	if diags.HasErrors() {
+		// All the potential errors we get back from this function are
+		// related to the user badly defining mocks. We should never hit
+		// this as we are just using the default behaviour.
+		panic(diags.Err())
+	}
+	stripped, stripDiags := ephemeral.StripWriteOnlyAttributesWithDiagnostics(val, schema.Body)
+	marked := marks.MarkPaths(stripped, marks.Sensitive, schema.Body.SensitivePaths(stripped, nil))
+	return marked, u.deferral(), stripDiags
+}
+
+// deferral is the Deferred value every unknownProvider method reports when
+// it defers an operation rather than erroring. It defaults to
+// providers.DeferredReasonProviderConfigUnknown, but can be overridden at
+// construction time with WithDeferralReason for stubs that stand in for a
+// provider with unknown instances rather than an unknown configuration.
+func (u *unknownProvider) deferral() *providers.Deferred {
+	reason := u.deferralReason
+	if reason == "" {
+		reason = providers.DeferredReasonProviderConfigUnknown
+	}
+	return &providers.Deferred{
+		Reason: reason,
 	}
 }
 
 func (u *unknownProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
 	// This is offline functionality, so we can hand it off to the unconfigured
-	// client.
+	// client. We always ask for the full schema here rather than a delta,
+	// since we have no previously cached schema of our own to apply one to.
+	// The schema's ServerCapabilities -- including DeferralSupported -- are
+	// returned verbatim, so supportsDeferral below always reflects whatever
+	// the unconfigured client itself reports.
 	return u.unconfiguredClient.GetProviderSchema()
 }
 
+// supportsDeferral reports whether the underlying provider has declared,
+// via providers.ServerCapabilities.DeferralSupported, that it's prepared to
+// receive deferred requests and honor the Deferred results this stub
+// fabricates on its behalf.
+//
+// This isn't currently consulted by the deferral-producing methods below,
+// because DeferralSupported isn't yet wired into the real plugin wire
+// protocol and so defaults to false for every provider that predates it --
+// gating the existing methods on it would turn every one of them into an
+// error for the common case instead of the deferral they're documented to
+// produce. It's exposed here so that callers with enough context to know
+// whether a particular unknown provider's capabilities are trustworthy
+// (for example, once the wire protocol grows a real capability) can check
+// before relying on a deferred result from this stub.
+func (u *unknownProvider) supportsDeferral() bool {
+	return u.GetProviderSchema().ServerCapabilities.DeferralSupported
+}
+
+// GetResourceIdentitySchemas delegates to the unconfigured client, caching
+// the result for the lifetime of this stub since identity schemas can't
+// change during an operation.
 func (u *unknownProvider) GetResourceIdentitySchemas() providers.GetResourceIdentitySchemasResponse {
-	return u.unconfiguredClient.GetResourceIdentitySchemas()
+	u.identitySchemasOnce.Do(func() {
+		u.identitySchemas = u.unconfiguredClient.GetResourceIdentitySchemas()
+	})
+	return u.identitySchemas
 }
 
 func (u *unknownProvider) ValidateProviderConfig(request providers.ValidateProviderConfigRequest) providers.ValidateProviderConfigResponse {
@@ -69,10 +231,10 @@ func (u *unknownProvider) ValidateListResourceConfig(request providers.ValidateL
 }
 
 // ValidateEphemeralResourceConfig implements providers.Interface.
-func (p *unknownProvider) ValidateEphemeralResourceConfig(providers.ValidateEphemeralResourceConfigRequest) providers.ValidateEphemeralResourceConfigResponse {
-	return providers.ValidateEphemeralResourceConfigResponse{
-		Diagnostics: nil,
-	}
+func (u *unknownProvider) ValidateEphemeralResourceConfig(request providers.ValidateEphemeralResourceConfigRequest) providers.ValidateEphemeralResourceConfigResponse {
+	// This is offline functionality, so we can hand it off to the unconfigured
+	// client.
+	return u.unconfiguredClient.ValidateEphemeralResourceConfig(request)
 }
 
 func (u *unknownProvider) UpgradeResourceState(request providers.UpgradeResourceStateRequest) providers.UpgradeResourceStateResponse {
@@ -94,8 +256,10 @@ func (u *unknownProvider) ConfigureProvider(_ providers.ConfigureProviderRequest
 }
 
 func (u *unknownProvider) Stop() error {
-	// the underlying unconfiguredClient is managed elsewhere.
-	return nil
+	// Offline methods like GetProviderSchema and UpgradeResourceState
+	// delegate straight through to the unconfigured client and can block, so
+	// we forward Stop to it to let those in-flight calls get cancelled.
+	return u.unconfiguredClient.Stop()
 }
 
 func (u *unknownProvider) ReadResource(request providers.ReadResourceRequest) providers.ReadResourceResponse {
@@ -104,23 +268,54 @@ func (u *unknownProvider) ReadResource(request providers.ReadResourceRequest) pr
 		// the operation.
 		return providers.ReadResourceResponse{
 			NewState: request.PriorState,
-			Deferred: &providers.Deferred{
-				Reason: providers.DeferredReasonProviderConfigUnknown,
-			},
+			Deferred: u.deferral(),
 		}
 	}
 	return providers.ReadResourceResponse{
 		Diagnostics: []tfdiags.Diagnostic{
-			tfdiags.AttributeValue(
-				tfdiags.Error,
-				"Provider configuration is unknown",
-				"Cannot read from this data source because its associated provider configuration is unknown.",
-				nil, // nil attribute path means the overall configuration block
-			),
+			providers.NewProviderConfigUnknownDiagnostic("read from this data source", u.label),
 		},
 	}
 }
 
+// ReadResources implements providers.BatchReader, letting the stack runtime
+// refresh many resource instances against this unknown provider in a single
+// call instead of one ReadResource call per instance.
+//
+// Every request in the batch defers for the same reason regardless of its
+// prior state, so we decide that once up front and reuse the same
+// *providers.Deferred value (and, for the non-deferrable case, the same
+// diagnostics slice) across the whole batch rather than allocating a fresh
+// one per instance, the way repeated calls to ReadResource would.
+func (u *unknownProvider) ReadResources(requests []providers.ReadResourceRequest) []providers.ReadResourceResponse {
+	responses := make([]providers.ReadResourceResponse, len(requests))
+
+	var deferred *providers.Deferred
+	var unconfiguredDiags tfdiags.Diagnostics
+	for i, request := range requests {
+		if !request.ClientCapabilities.DeferralAllowed {
+			if unconfiguredDiags == nil {
+				unconfiguredDiags = tfdiags.Diagnostics{
+					providers.NewProviderConfigUnknownDiagnostic("read from this data source", u.label),
+				}
+			}
+			responses[i] = providers.ReadResourceResponse{
+				Diagnostics: unconfiguredDiags,
+			}
+			continue
+		}
+		if deferred == nil {
+			deferred = u.deferral()
+		}
+		responses[i] = providers.ReadResourceResponse{
+			NewState: request.PriorState,
+			Deferred: deferred,
+		}
+	}
+
+	return responses
+}
+
 // GenerateResourceConfig implements providers.Interface
 func (p *unknownProvider) GenerateResourceConfig(req providers.GenerateResourceConfigRequest) providers.GenerateResourceConfigResponse {
 	panic("not implemented")
@@ -128,51 +323,29 @@ func (p *unknownProvider) GenerateResourceConfig(req providers.GenerateResourceC
 
 func (u *unknownProvider) PlanResourceChange(request providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
 	if request.ClientCapabilities.DeferralAllowed {
-		// For PlanResourceChange, we'll kind of abuse the mocking library to
-		// populate the computed values with unknown values so that future
-		// operations can still be used.
-		//
-		// PlanComputedValuesForResource populates the computed values with
-		// unknown values. This isn't the original use case for the mocking
-		// library, but it is doing exactly what we need it to do.
-
 		schema := u.GetProviderSchema().ResourceTypes[request.TypeName]
-		val, diags := mocking.PlanComputedValuesForResource(request.ProposedNewState, nil, schema.Body)
-		if diags.HasErrors() {
-			// All the potential errors we get back from this function are
-			// related to the user badly defining mocks. We should never hit
-			// this as we are just using the default behaviour.
-			panic(diags.Err())
-		}
-
+		val, deferred, diags := u.unknownStateForSchema(schema, request.ProposedNewState)
 		return providers.PlanResourceChangeResponse{
-			PlannedState: ephemeral.StripWriteOnlyAttributes(val, schema.Body),
-			Deferred: &providers.Deferred{
-				Reason: providers.DeferredReasonProviderConfigUnknown,
-			},
+			PlannedState: val,
+			Deferred:     deferred,
+			Diagnostics:  diags,
 		}
 	}
 	return providers.PlanResourceChangeResponse{
 		Diagnostics: []tfdiags.Diagnostic{
-			tfdiags.AttributeValue(
-				tfdiags.Error,
-				"Provider configuration is unknown",
-				"Cannot plan changes for this resource because its associated provider configuration is unknown.",
-				nil, // nil attribute path means the overall configuration block
-			),
+			providers.NewProviderConfigUnknownDiagnostic("plan changes for this resource", u.label),
 		},
 	}
 }
 
+// ApplyResourceChange always fails, because a resource whose provider
+// configuration is still unknown can never actually be applied. Since apply
+// never succeeds here, Core never reaches the point of evaluating the
+// resource's postconditions against an applied result for this resource.
 func (u *unknownProvider) ApplyResourceChange(_ providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
 	return providers.ApplyResourceChangeResponse{
 		Diagnostics: []tfdiags.Diagnostic{
-			tfdiags.AttributeValue(
-				tfdiags.Error,
-				"Provider configuration is unknown",
-				"Cannot apply changes for this resource because its associated provider configuration is unknown.",
-				nil, // nil attribute path means the overall configuration block
-			),
+			providers.NewProviderConfigUnknownDiagnostic("apply changes for this resource", u.label),
 		},
 	}
 }
@@ -187,96 +360,109 @@ func (u *unknownProvider) ImportResourceState(request providers.ImportResourceSt
 		// alongside the deferred metadata.
 
 		schema := u.GetProviderSchema().ResourceTypes[request.TypeName]
+		imported := providers.ImportedResource{
+			TypeName: request.TypeName,
+			State:    cty.UnknownVal(schema.Body.ImpliedType()),
+		}
+		if identitySchema, ok := u.GetResourceIdentitySchemas().IdentityTypes[request.TypeName]; ok && identitySchema.Body != nil {
+			// If the resource type has an identity schema then we also need
+			// to return an unknown identity value of the right type, so that
+			// identity-based refresh and drift detection still have
+			// something to work with once the deferral resolves.
+			imported.Identity = cty.UnknownVal(identitySchema.Body.ImpliedType())
+		}
 		return providers.ImportResourceStateResponse{
-			ImportedResources: []providers.ImportedResource{
-				{
-					TypeName: request.TypeName,
-					State:    cty.UnknownVal(schema.Body.ImpliedType()),
-				},
-			},
-			Deferred: &providers.Deferred{
-				Reason: providers.DeferredReasonProviderConfigUnknown,
-			},
+			ImportedResources: []providers.ImportedResource{imported},
+			Deferred:          u.deferral(),
 		}
 	}
 	return providers.ImportResourceStateResponse{
 		Diagnostics: []tfdiags.Diagnostic{
-			tfdiags.AttributeValue(
-				tfdiags.Error,
-				"Provider configuration is unknown",
-				"Cannot import an existing object into this resource because its associated provider configuration is unknown.",
-				nil, // nil attribute path means the overall configuration block
-			),
+			providers.NewProviderConfigUnknownDiagnostic("import an existing object into this resource", u.label),
 		},
 	}
 }
 
-func (u *unknownProvider) MoveResourceState(_ providers.MoveResourceStateRequest) providers.MoveResourceStateResponse {
-	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Called MoveResourceState on an unknown provider",
-		"Terraform called MoveResourceState on an unknown provider. This is a bug in Terraform - please report this error.",
-		nil, // nil attribute path means the overall configuration block
-	))
-	return providers.MoveResourceStateResponse{
-		Diagnostics: diags,
+// MoveResourceState is offline functionality -- it's a pure transformation
+// of prior state data, not something that needs the provider to actually be
+// configured -- so unlike most other methods here it's legitimate to call
+// this while refactoring a "moved" block whose source provider configuration
+// is still unknown. We hand it off to the unconfigured client, the same way
+// UpgradeResourceState and GetProviderSchema do.
+func (u *unknownProvider) MoveResourceState(request providers.MoveResourceStateRequest) providers.MoveResourceStateResponse {
+	resp := u.unconfiguredClient.MoveResourceState(request)
+	if !resp.Diagnostics.HasErrors() && resp.TargetState == cty.NilVal {
+		// The unconfigured client returned successfully but without actually
+		// producing a moved state, which means it doesn't implement a
+		// meaningful MoveResourceState for this resource type. Report that
+		// plainly instead of handing back an empty result.
+		var diags tfdiags.Diagnostics
+		diags = diags.Append(tfdiags.AttributeValue(
+			tfdiags.Error,
+			"Provider does not support moving this resource's state",
+			fmt.Sprintf("Cannot move state into %q: the provider did not return a resulting state. This provider may not implement MoveResourceState for this resource type.", request.SourceTypeName),
+			nil, // nil attribute path means the overall configuration block
+		))
+		return providers.MoveResourceStateResponse{
+			Diagnostics: diags,
+		}
 	}
+	return resp
 }
 
 func (u *unknownProvider) ReadDataSource(request providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
 	if request.ClientCapabilities.DeferralAllowed {
-		// For ReadDataSource, we'll kind of abuse the mocking library to
-		// populate the computed values with unknown values so that future
-		// operations can still be used.
-		//
-		// PlanComputedValuesForResource populates the computed values with
-		// unknown values. This isn't the original use case for the mocking
-		// library, but it is doing exactly what we need it to do.
+		// A cache hit only applies when the data source's own configuration
+		// is fully known -- it's specifically the provider configuration
+		// that's unknown here, which the cached result doesn't depend on --
+		// so we replay whatever Deferred result was recorded alongside it
+		// rather than recomputing it. A caller that wires in a cache is
+		// opting into treating a repeat read of the same known
+		// configuration as settled enough to reuse, not into dropping the
+		// deferral that every unknownProvider read otherwise carries.
+		if u.dataSourceReadCache != nil && request.Config.IsWhollyKnown() {
+			if entry, ok := u.dataSourceReadCache.lookup(request.TypeName, request.Config); ok {
+				return providers.ReadDataSourceResponse{
+					State:       entry.state,
+					Deferred:    entry.deferred,
+					Diagnostics: entry.diags,
+				}
+			}
+		}
 
 		schema := u.GetProviderSchema().DataSources[request.TypeName]
-		val, diags := mocking.PlanComputedValuesForResource(request.Config, nil, schema.Body)
-		if diags.HasErrors() {
-			// All the potential errors we get back from this function are
-			// related to the user badly defining mocks. We should never hit
-			// this as we are just using the default behaviour.
-			panic(diags.Err())
+		val, deferred, diags := u.unknownStateForSchema(schema, request.Config)
+		if u.dataSourceReadCache != nil && request.Config.IsWhollyKnown() && !diags.HasErrors() {
+			u.dataSourceReadCache.record(request.TypeName, request.Config, val, deferred, diags)
 		}
-
 		return providers.ReadDataSourceResponse{
-			State: ephemeral.StripWriteOnlyAttributes(val, schema.Body),
-			Deferred: &providers.Deferred{
-				Reason: providers.DeferredReasonProviderConfigUnknown,
-			},
+			State:       val,
+			Deferred:    deferred,
+			Diagnostics: diags,
 		}
 	}
 	return providers.ReadDataSourceResponse{
 		Diagnostics: []tfdiags.Diagnostic{
-			tfdiags.AttributeValue(
-				tfdiags.Error,
-				"Provider configuration is unknown",
-				"Cannot read from this data source because its associated provider configuration is unknown.",
-				nil, // nil attribute path means the overall configuration block
-			),
+			providers.NewProviderConfigUnknownDiagnostic("read from this data source", u.label),
 		},
 	}
 }
 
 // OpenEphemeralResource implements providers.Interface.
-func (u *unknownProvider) OpenEphemeralResource(providers.OpenEphemeralResourceRequest) providers.OpenEphemeralResourceResponse {
-	// TODO: Once there's a definition for how deferred actions ought to work
-	// for ephemeral resource instances, make this report that this one needs
-	// to be deferred if the client announced that it supports deferral.
-	//
-	// For now this is just always an error, because ephemeral resources are
-	// just a prototype being developed concurrently with deferred actions.
+func (u *unknownProvider) OpenEphemeralResource(request providers.OpenEphemeralResourceRequest) providers.OpenEphemeralResourceResponse {
+	if request.ClientCapabilities.DeferralAllowed {
+		// We don't know enough about the eventual provider configuration to
+		// actually open anything yet, so we'll just return an unknown value
+		// of the right type and defer the rest of the work until the
+		// provider configuration is known.
+		schema := u.GetProviderSchema().EphemeralResourceTypes[request.TypeName]
+		return providers.OpenEphemeralResourceResponse{
+			Result:   cty.UnknownVal(schema.Body.ImpliedType()),
+			Deferred: u.deferral(),
+		}
+	}
 	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Provider configuration is unknown",
-		"Cannot open this resource instance because its associated provider configuration is unknown.",
-		nil, // nil attribute path means the overall configuration block
-	))
+	diags = diags.Append(providers.NewProviderConfigUnknownDiagnostic("open this resource instance", u.label))
 	return providers.OpenEphemeralResourceResponse{
 		Diagnostics: diags,
 	}
@@ -296,12 +482,49 @@ func (u *unknownProvider) CloseEphemeralResource(providers.CloseEphemeralResourc
 	return providers.CloseEphemeralResourceResponse{}
 }
 
-func (u *unknownProvider) CallFunction(_ providers.CallFunctionRequest) providers.CallFunctionResponse {
+func (u *unknownProvider) CallFunction(req providers.CallFunctionRequest) providers.CallFunctionResponse {
+	if !u.deferFunctionCalls {
+		return providers.CallFunctionResponse{
+			Err: fmt.Errorf("CallFunction shouldn't be called on an unknown provider; this is a bug in Terraform - please report this error"),
+		}
+	}
+
+	decl, ok := u.GetProviderSchema().Functions[req.FunctionName]
+	if !ok {
+		return providers.CallFunctionResponse{
+			Err: fmt.Errorf("unknown function %q", req.FunctionName),
+		}
+	}
 	return providers.CallFunctionResponse{
-		Err: fmt.Errorf("CallFunction shouldn't be called on an unknown provider; this is a bug in Terraform - please report this error"),
+		Result: cty.UnknownVal(decl.ReturnType),
+	}
+}
+
+// CallFunctionBatch implements providers.BatchFunctionCaller, letting Core
+// evaluate many calls to the same function on this unknown provider in a
+// single call instead of one CallFunction call per element of whatever
+// collection it's expanding across.
+//
+// Every request in the batch is handled exactly as CallFunction would
+// handle it individually, including asking the unconfigured client for its
+// schema once per request, so the batch saves only the per-call round trip
+// itself.
+func (u *unknownProvider) CallFunctionBatch(requests []providers.CallFunctionRequest) []providers.CallFunctionResponse {
+	responses := make([]providers.CallFunctionResponse, len(requests))
+	for i, request := range requests {
+		responses[i] = u.CallFunction(request)
 	}
+	return responses
 }
 
+// ListResource implements providers.Interface.
+//
+// Unlike ReadResource and ReadDataSource, ListResourceRequest carries no
+// ClientCapabilities and ListResourceResponse has no Deferred field, so
+// there's no way for a caller to opt in to a deferred result here and no
+// way for us to report one. Until the list-resource RPC grows the same
+// deferral capability the rest of this provider already honors, calling
+// ListResource on an unknown provider remains a hard error.
 func (u *unknownProvider) ListResource(providers.ListResourceRequest) providers.ListResourceResponse {
 	var resp providers.ListResourceResponse
 	resp.Diagnostics = resp.Diagnostics.Append(tfdiags.AttributeValue(
@@ -316,12 +539,7 @@ func (u *unknownProvider) ListResource(providers.ListResourceRequest) providers.
 // ValidateStateStoreConfig implements providers.Interface.
 func (u *unknownProvider) ValidateStateStoreConfig(providers.ValidateStateStoreConfigRequest) providers.ValidateStateStoreConfigResponse {
 	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Provider configuration is unknown",
-		"Cannot validate this state store because its associated provider configuration is unknown.",
-		nil, // nil attribute path means the overall configuration block
-	))
+	diags = diags.Append(providers.NewProviderConfigUnknownDiagnostic("validate this state store", u.label))
 	return providers.ValidateStateStoreConfigResponse{
 		Diagnostics: diags,
 	}
@@ -330,12 +548,7 @@ func (u *unknownProvider) ValidateStateStoreConfig(providers.ValidateStateStoreC
 // ConfigureStateStore implements providers.Interface.
 func (u *unknownProvider) ConfigureStateStore(providers.ConfigureStateStoreRequest) providers.ConfigureStateStoreResponse {
 	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Provider configuration is unknown",
-		"Cannot configure this state store because its associated provider configuration is unknown.",
-		nil, // nil attribute path means the overall configuration block
-	))
+	diags = diags.Append(providers.NewProviderConfigUnknownDiagnostic("configure this state store", u.label))
 	return providers.ConfigureStateStoreResponse{
 		Diagnostics: diags,
 	}
@@ -344,12 +557,7 @@ func (u *unknownProvider) ConfigureStateStore(providers.ConfigureStateStoreReque
 // ReadStateBytes implements providers.Interface.
 func (u *unknownProvider) ReadStateBytes(providers.ReadStateBytesRequest) providers.ReadStateBytesResponse {
 	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Provider configuration is unknown",
-		"Cannot read from this state store because its associated provider configuration is unknown.",
-		nil, // nil attribute path means the overall configuration block
-	))
+	diags = diags.Append(providers.NewProviderConfigUnknownDiagnostic("read from this state store", u.label))
 	return providers.ReadStateBytesResponse{
 		Diagnostics: diags,
 	}
@@ -358,12 +566,7 @@ func (u *unknownProvider) ReadStateBytes(providers.ReadStateBytesRequest) provid
 // WriteStateBytes implements providers.Interface.
 func (u *unknownProvider) WriteStateBytes(providers.WriteStateBytesRequest) providers.WriteStateBytesResponse {
 	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Provider configuration is unknown",
-		"Cannot write to this state store because its associated provider configuration is unknown.",
-		nil, // nil attribute path means the overall configuration block
-	))
+	diags = diags.Append(providers.NewProviderConfigUnknownDiagnostic("write to this state store", u.label))
 	return providers.WriteStateBytesResponse{
 		Diagnostics: diags,
 	}
@@ -371,12 +574,7 @@ func (u *unknownProvider) WriteStateBytes(providers.WriteStateBytesRequest) prov
 
 func (u *unknownProvider) LockState(req providers.LockStateRequest) providers.LockStateResponse {
 	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Provider configuration is unknown",
-		"Cannot lock to this state store because its associated provider configuration is unknown.",
-		nil, // nil attribute path means the overall configuration block
-	))
+	diags = diags.Append(providers.NewProviderConfigUnknownDiagnostic("lock to this state store", u.label))
 	return providers.LockStateResponse{
 		Diagnostics: diags,
 	}
@@ -384,12 +582,7 @@ func (u *unknownProvider) LockState(req providers.LockStateRequest) providers.Lo
 
 func (u *unknownProvider) UnlockState(req providers.UnlockStateRequest) providers.UnlockStateResponse {
 	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Provider configuration is unknown",
-		"Cannot unlock to this state store because its associated provider configuration is unknown.",
-		nil, // nil attribute path means the overall configuration block
-	))
+	diags = diags.Append(providers.NewProviderConfigUnknownDiagnostic("unlock to this state store", u.label))
 	return providers.UnlockStateResponse{
 		Diagnostics: diags,
 	}
@@ -398,12 +591,7 @@ func (u *unknownProvider) UnlockState(req providers.UnlockStateRequest) provider
 // GetStates implements providers.Interface.
 func (u *unknownProvider) GetStates(providers.GetStatesRequest) providers.GetStatesResponse {
 	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Provider configuration is unknown",
-		"Cannot list states managed by this state store because its associated provider configuration is unknown.",
-		nil, // nil attribute path means the overall configuration block
-	))
+	diags = diags.Append(providers.NewProviderConfigUnknownDiagnostic("list states managed by this state store", u.label))
 	return providers.GetStatesResponse{
 		Diagnostics: diags,
 	}
@@ -412,12 +600,7 @@ func (u *unknownProvider) GetStates(providers.GetStatesRequest) providers.GetSta
 // DeleteState implements providers.Interface.
 func (u *unknownProvider) DeleteState(providers.DeleteStateRequest) providers.DeleteStateResponse {
 	var diags tfdiags.Diagnostics
-	diags = diags.Append(tfdiags.AttributeValue(
-		tfdiags.Error,
-		"Provider configuration is unknown",
-		"Cannot use this state store to delete a state because its associated provider configuration is unknown.",
-		nil, // nil attribute path means the overall configuration block
-	))
+	diags = diags.Append(providers.NewProviderConfigUnknownDiagnostic("use this state store to delete a state", u.label))
 	return providers.DeleteStateResponse{
 		Diagnostics: diags,
 	}
@@ -425,42 +608,56 @@ func (u *unknownProvider) DeleteState(providers.DeleteStateRequest) providers.De
 
 // PlanAction implements providers.Interface.
 func (u *unknownProvider) PlanAction(request providers.PlanActionRequest) providers.PlanActionResponse {
-	// TODO: Once actions support deferrals we can implement this
+	if request.ClientCapabilities.DeferralAllowed {
+		// Unlike PlanResourceChange, PlanActionResponse has no field for a
+		// planned value to populate with unknowns via the mocking package,
+		// so there's nothing more we can report here beyond the deferral
+		// itself.
+		return providers.PlanActionResponse{
+			Deferred: u.deferral(),
+		}
+	}
 	return providers.PlanActionResponse{
 		Diagnostics: []tfdiags.Diagnostic{
-			tfdiags.AttributeValue(
-				tfdiags.Error,
-				"Provider configuration is unknown",
-				"Cannot plan this action because its associated provider configuration is unknown.",
-				nil, // nil attribute path means the overall configuration block
-			),
+			providers.NewProviderConfigUnknownDiagnostic("plan this action", u.label),
 		},
 	}
 }
 
 // InvokeAction implements providers.Interface.
 func (u *unknownProvider) InvokeAction(request providers.InvokeActionRequest) providers.InvokeActionResponse {
+	if request.ClientCapabilities.DeferralAllowed {
+		// Like PlanAction, there's no meaningful result to report here
+		// beyond the deferral itself: the action was never actually
+		// invoked, so there are no events and no side effects to report.
+		return providers.InvokeActionResponse{
+			Deferred: u.deferral(),
+		}
+	}
 	return providers.InvokeActionResponse{
 		Diagnostics: []tfdiags.Diagnostic{
-			tfdiags.AttributeValue(
-				tfdiags.Error,
-				"Provider configuration is unknown",
-				"Cannot invoke this action because its associated provider configuration is unknown.",
-				nil, // nil attribute path means the overall configuration block
-			),
+			providers.NewProviderConfigUnknownDiagnostic("invoke this action", u.label),
 		},
 	}
 }
 
 func (u *unknownProvider) ValidateActionConfig(request providers.ValidateActionConfigRequest) providers.ValidateActionConfigResponse {
+	// Like ValidateResourceConfig, this is offline functionality, so we can
+	// hand it off to the unconfigured client as long as it actually knows
+	// about the action type being validated. If it doesn't, the unconfigured
+	// client's own response would just be a confusing "unknown resource
+	// type" diagnostic that doesn't mention the real problem, so we fall
+	// back to our usual unknown-provider-configuration error instead.
+	schema := u.unconfiguredClient.GetProviderSchema()
+	if !schema.Diagnostics.HasErrors() {
+		if _, ok := schema.Actions[request.TypeName]; ok {
+			return u.unconfiguredClient.ValidateActionConfig(request)
+		}
+	}
+
 	return providers.ValidateActionConfigResponse{
 		Diagnostics: []tfdiags.Diagnostic{
-			tfdiags.AttributeValue(
-				tfdiags.Error,
-				"Provider configuration is unknown",
-				"Cannot validate this action configuration because its associated provider configuration is unknown.",
-				nil, // nil attribute path means the overall configuration block
-			),
+			providers.NewProviderConfigUnknownDiagnostic("validate this action configuration", u.label),
 		},
 	}
 }
@@ -469,3 +666,54 @@ func (u *unknownProvider) Close() error {
 	// the underlying unconfiguredClient is managed elsewhere.
 	return nil
 }
+
+var _ providers.Transactional = (*unknownProvider)(nil)
+
+// BeginTransaction implements providers.Transactional. An unknown provider
+// never actually applies anything, so there is nothing to open a
+// transaction around; we treat this as a no-op so that callers which
+// unconditionally bracket their applies in a transaction don't need a
+// special case for unknown providers.
+func (u *unknownProvider) BeginTransaction(providers.BeginTransactionRequest) providers.BeginTransactionResponse {
+	return providers.BeginTransactionResponse{}
+}
+
+// CommitTransaction implements providers.Transactional.
+func (u *unknownProvider) CommitTransaction(providers.CommitTransactionRequest) providers.CommitTransactionResponse {
+	var diags tfdiags.Diagnostics
+	diags = diags.Append(tfdiags.AttributeValue(
+		tfdiags.Error,
+		"Called CommitTransaction on an unknown provider",
+		"Terraform called CommitTransaction on an unknown provider. This is a bug in Terraform - please report this error.",
+		nil, // nil attribute path means the overall configuration block
+	))
+	return providers.CommitTransactionResponse{
+		Diagnostics: diags,
+	}
+}
+
+// RollbackTransaction implements providers.Transactional.
+func (u *unknownProvider) RollbackTransaction(providers.RollbackTransactionRequest) providers.RollbackTransactionResponse {
+	// Rolling back an unknown provider is always safe: it never applied
+	// anything in the first place.
+	return providers.RollbackTransactionResponse{}
+}
+
+var _ providers.ApplyConfirmer = (*unknownProvider)(nil)
+
+// ConfirmApply implements providers.ApplyConfirmer. An unknown provider
+// should never reach the apply step at all, since its configuration isn't
+// known yet, so reaching this method is always a bug rather than something
+// a caller can legitimately trigger.
+func (u *unknownProvider) ConfirmApply(providers.ConfirmApplyRequest) providers.ConfirmApplyResponse {
+	var diags tfdiags.Diagnostics
+	diags = diags.Append(tfdiags.AttributeValue(
+		tfdiags.Error,
+		"Called ConfirmApply on an unknown provider",
+		"Terraform called ConfirmApply on an unknown provider. This is a bug in Terraform - please report this error.",
+		nil, // nil attribute path means the overall configuration block
+	))
+	return providers.ConfirmApplyResponse{
+		Diagnostics: diags,
+	}
+}
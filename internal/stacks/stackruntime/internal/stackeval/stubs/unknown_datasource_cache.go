@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package stubs
+
+import (
+	"crypto/sha256"
+	"io"
+	"sync"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// DataSourceReadCache is an optional cache of unknownProvider's
+// ReadDataSource results, keyed by data source type name and configuration.
+// Attach one to a stub constructed by UnknownProvider or
+// UnknownProviderDeferringFunctions with WithDataSourceReadCache.
+//
+// unknownProvider's ReadDataSource result for a given type name and
+// configuration is always the same, since it's fabricated entirely from the
+// schema and the configuration rather than from any real external state.
+// Attaching a cache lets repeated reads of the same data source and
+// configuration -- for example, across successive partial plans of a stack
+// whose provider configuration remains unknown for a while -- reuse the
+// earlier result instead of recomputing it.
+//
+// A single DataSourceReadCache can be shared across every unknownProvider
+// stub standing in for the same unconfigured provider across an operation;
+// it has no dependency on any particular stub instance.
+type DataSourceReadCache struct {
+	mu      sync.Mutex
+	results map[[sha256.Size]byte]dataSourceReadCacheEntry
+}
+
+type dataSourceReadCacheEntry struct {
+	state    cty.Value
+	deferred *providers.Deferred
+	diags    tfdiags.Diagnostics
+}
+
+// NewDataSourceReadCache returns an empty DataSourceReadCache, ready to use.
+func NewDataSourceReadCache() *DataSourceReadCache {
+	return &DataSourceReadCache{
+		results: make(map[[sha256.Size]byte]dataSourceReadCacheEntry),
+	}
+}
+
+func (c *DataSourceReadCache) key(typeName string, config cty.Value) [sha256.Size]byte {
+	sum := sha256.New()
+	io.WriteString(sum, typeName)
+	io.WriteString(sum, "|"+config.GoString())
+	return [sha256.Size]byte(sum.Sum(nil))
+}
+
+// lookup returns the cached result for typeName and config, if there is
+// one.
+func (c *DataSourceReadCache) lookup(typeName string, config cty.Value) (dataSourceReadCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.results[c.key(typeName, config)]
+	return entry, ok
+}
+
+// record stores state, deferred, and diags as the result for typeName and
+// config, so that a later read of the same type and configuration can reuse
+// it.
+func (c *DataSourceReadCache) record(typeName string, config cty.Value, state cty.Value, deferred *providers.Deferred, diags tfdiags.Diagnostics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.results[c.key(typeName, config)] = dataSourceReadCacheEntry{
+		state:    state,
+		deferred: deferred,
+		diags:    diags,
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package stubs
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/providers"
+)
+
+func TestUnknownStateForSchema(t *testing.T) {
+	schema := providers.Schema{
+		Body: &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"name": {
+					Type:     cty.String,
+					Required: true,
+				},
+				"id": {
+					Type:     cty.String,
+					Computed: true,
+				},
+				"password": {
+					Type:      cty.String,
+					Computed:  true,
+					WriteOnly: true,
+				},
+				"tags": {
+					Type:     cty.Map(cty.String),
+					Computed: true,
+				},
+			},
+			BlockTypes: map[string]*configschema.NestedBlock{
+				"settings": {
+					Block: configschema.Block{
+						Attributes: map[string]*configschema.Attribute{
+							"retries": {
+								Type:     cty.Number,
+								Computed: true,
+							},
+						},
+					},
+					Nesting: configschema.NestingSingle,
+				},
+			},
+		},
+	}
+
+	config := cty.ObjectVal(map[string]cty.Value{
+		"name":     cty.StringVal("example"),
+		"id":       cty.NullVal(cty.String),
+		"password": cty.NullVal(cty.String),
+		"tags":     cty.NullVal(cty.Map(cty.String)),
+		"settings": cty.ObjectVal(map[string]cty.Value{
+			"retries": cty.NullVal(cty.Number),
+		}),
+	})
+
+	u := &unknownProvider{}
+	val, deferred, diags := u.unknownStateForSchema(schema, config)
+
+	if deferred == nil {
+		t.Fatal("expected a non-nil deferred result")
+	}
+	if deferred.Reason != providers.DeferredReasonProviderConfigUnknown {
+		t.Fatalf("wrong deferred reason: %s", deferred.Reason)
+	}
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if len(diags) != 0 {
+		t.Fatalf("expected no diagnostics since the write-only attribute was already null, got %s", diags.Err())
+	}
+
+	atts := val.AsValueMap()
+
+	if got, want := atts["name"], cty.StringVal("example"); !got.RawEquals(want) {
+		t.Fatalf("expected the explicitly configured attribute to be preserved, got %#v", got)
+	}
+	if atts["id"].IsKnown() {
+		t.Fatalf("expected the computed attribute to be unknown, got %#v", atts["id"])
+	}
+	if !atts["password"].IsNull() {
+		t.Fatalf("expected the write-only attribute to be stripped to null, got %#v", atts["password"])
+	}
+	if atts["tags"].IsKnown() {
+		t.Fatalf("expected the computed collection attribute to be unknown, got %#v", atts["tags"])
+	}
+
+	settings := atts["settings"].AsValueMap()
+	if settings["retries"].IsKnown() {
+		t.Fatalf("expected the nested computed attribute to be unknown, got %#v", settings["retries"])
+	}
+}
+
+func TestUnknownStateForSchemaMarksSensitiveAttributes(t *testing.T) {
+	schema := providers.Schema{
+		Body: &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"name": {
+					Type:     cty.String,
+					Required: true,
+				},
+				"token": {
+					Type:      cty.String,
+					Computed:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+
+	config := cty.ObjectVal(map[string]cty.Value{
+		"name":  cty.StringVal("example"),
+		"token": cty.NullVal(cty.String),
+	})
+
+	u := &unknownProvider{}
+	val, _, diags := u.unknownStateForSchema(schema, config)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	atts := val.AsValueMap()
+	if atts["name"].IsMarked() {
+		t.Fatalf("expected the non-sensitive attribute to be unmarked, got %#v", atts["name"])
+	}
+	if !atts["token"].IsMarked() {
+		t.Fatalf("expected the sensitive attribute to be marked, got %#v", atts["token"])
+	}
+}
@@ -0,0 +1,1076 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package stubs
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/providers"
+	testing_provider "github.com/hashicorp/terraform/internal/providers/testing"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+func TestUnknownProviderPlanResourceChangeWarnsOnWriteOnlyValue(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{
+		GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+			ResourceTypes: map[string]providers.Schema{
+				"test_thing": {
+					Body: &configschema.Block{
+						Attributes: map[string]*configschema.Attribute{
+							"password": {
+								Type:      cty.String,
+								Optional:  true,
+								WriteOnly: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	provider := UnknownProvider(unconfiguredClient, "")
+
+	resp := provider.PlanResourceChange(providers.PlanResourceChangeRequest{
+		TypeName: "test_thing",
+		ProposedNewState: cty.ObjectVal(map[string]cty.Value{
+			"password": cty.StringVal("secret"),
+		}),
+		ClientCapabilities: providers.ClientCapabilities{
+			DeferralAllowed: true,
+		},
+	})
+	if resp.Diagnostics.HasErrors() {
+		t.Fatalf("unexpected errors: %s", resp.Diagnostics.Err())
+	}
+	if len(resp.Diagnostics) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %d: %s", len(resp.Diagnostics), resp.Diagnostics.Err())
+	}
+	if !strings.Contains(resp.Diagnostics[0].Description().Summary, "Write-only") {
+		t.Fatalf("expected a write-only warning, got: %#v", resp.Diagnostics[0].Description())
+	}
+}
+
+func TestUnknownProviderPlanResourceChangeDestroy(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{
+		GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+			ResourceTypes: map[string]providers.Schema{
+				"test_thing": {
+					Body: &configschema.Block{
+						Attributes: map[string]*configschema.Attribute{
+							"id": {
+								Type:     cty.String,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	provider := UnknownProvider(unconfiguredClient, "")
+
+	t.Run("destroy is deferred rather than erroring when the client allows it", func(t *testing.T) {
+		resp := provider.PlanResourceChange(providers.PlanResourceChangeRequest{
+			TypeName:         "test_thing",
+			PriorState:       cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("existing")}),
+			ProposedNewState: cty.NullVal(cty.Object(map[string]cty.Type{"id": cty.String})),
+			ClientCapabilities: providers.ClientCapabilities{
+				DeferralAllowed: true,
+			},
+		})
+		if resp.Diagnostics.HasErrors() {
+			t.Fatalf("unexpected errors: %s", resp.Diagnostics.Err())
+		}
+		if resp.Deferred == nil {
+			t.Fatal("expected the destroy to be deferred, but it was not")
+		}
+		if resp.Deferred.Reason != providers.DeferredReasonProviderConfigUnknown {
+			t.Fatalf("wrong deferred reason: %s", resp.Deferred.Reason)
+		}
+		if !resp.PlannedState.IsNull() {
+			t.Fatalf("expected the planned state for a destroy to remain null, got %#v", resp.PlannedState)
+		}
+	})
+
+	t.Run("destroy errors when the client doesn't support deferral", func(t *testing.T) {
+		resp := provider.PlanResourceChange(providers.PlanResourceChangeRequest{
+			TypeName:           "test_thing",
+			PriorState:         cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("existing")}),
+			ProposedNewState:   cty.NullVal(cty.Object(map[string]cty.Type{"id": cty.String})),
+			ClientCapabilities: providers.ClientCapabilities{},
+		})
+		if resp.Deferred != nil {
+			t.Fatal("expected no deferral when the client doesn't support it")
+		}
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected an error, but there was none")
+		}
+	})
+}
+
+func TestUnknownProviderOpenEphemeralResource(t *testing.T) {
+	ephemeralType := cty.Object(map[string]cty.Type{"id": cty.String})
+	unconfiguredClient := &testing_provider.MockProvider{
+		GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+			EphemeralResourceTypes: map[string]providers.Schema{
+				"test_thing": {
+					Body: &configschema.Block{
+						Attributes: map[string]*configschema.Attribute{
+							"id": {
+								Type:     cty.String,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	provider := UnknownProvider(unconfiguredClient, "")
+
+	t.Run("open is deferred rather than erroring when the client allows it", func(t *testing.T) {
+		resp := provider.OpenEphemeralResource(providers.OpenEphemeralResourceRequest{
+			TypeName: "test_thing",
+			ClientCapabilities: providers.ClientCapabilities{
+				DeferralAllowed: true,
+			},
+		})
+		if resp.Diagnostics.HasErrors() {
+			t.Fatalf("unexpected errors: %s", resp.Diagnostics.Err())
+		}
+		if resp.Deferred == nil {
+			t.Fatal("expected the open to be deferred, but it was not")
+		}
+		if resp.Deferred.Reason != providers.DeferredReasonProviderConfigUnknown {
+			t.Fatalf("wrong deferred reason: %s", resp.Deferred.Reason)
+		}
+		if resp.Result.IsKnown() {
+			t.Fatalf("expected an unknown result, got %#v", resp.Result)
+		}
+		if got, want := resp.Result.Type(), ephemeralType; !got.Equals(want) {
+			t.Fatalf("wrong result type: got %#v, want %#v", got, want)
+		}
+
+		renewResp := provider.RenewEphemeralResource(providers.RenewEphemeralResourceRequest{})
+		if renewResp.Diagnostics.HasErrors() {
+			t.Fatalf("expected RenewEphemeralResource to remain a no-op, but got errors: %s", renewResp.Diagnostics.Err())
+		}
+
+		closeResp := provider.CloseEphemeralResource(providers.CloseEphemeralResourceRequest{})
+		if closeResp.Diagnostics.HasErrors() {
+			t.Fatalf("expected CloseEphemeralResource to remain a no-op, but got errors: %s", closeResp.Diagnostics.Err())
+		}
+	})
+
+	t.Run("open errors when the client doesn't support deferral", func(t *testing.T) {
+		resp := provider.OpenEphemeralResource(providers.OpenEphemeralResourceRequest{
+			TypeName:           "test_thing",
+			ClientCapabilities: providers.ClientCapabilities{},
+		})
+		if resp.Deferred != nil {
+			t.Fatal("expected no deferral when the client doesn't support it")
+		}
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected an error, but there was none")
+		}
+	})
+}
+
+func TestUnknownProviderCallFunction(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{
+		GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+			Functions: map[string]providers.FunctionDecl{
+				"greet": {
+					ReturnType: cty.String,
+				},
+			},
+		},
+	}
+
+	t.Run("errors by default", func(t *testing.T) {
+		provider := UnknownProvider(unconfiguredClient, "")
+		resp := provider.CallFunction(providers.CallFunctionRequest{
+			FunctionName: "greet",
+			Arguments:    []cty.Value{cty.StringVal("world")},
+		})
+		if resp.Err == nil {
+			t.Fatal("expected an error, but there was none")
+		}
+	})
+
+	t.Run("defers to an unknown result when configured to", func(t *testing.T) {
+		provider := UnknownProviderDeferringFunctions(unconfiguredClient, "")
+		resp := provider.CallFunction(providers.CallFunctionRequest{
+			FunctionName: "greet",
+			Arguments:    []cty.Value{cty.StringVal("world")},
+		})
+		if resp.Err != nil {
+			t.Fatalf("unexpected error: %s", resp.Err)
+		}
+		if resp.Result.IsKnown() {
+			t.Fatalf("expected an unknown result, got %#v", resp.Result)
+		}
+		if got, want := resp.Result.Type(), cty.String; !got.Equals(want) {
+			t.Fatalf("wrong result type: got %#v, want %#v", got, want)
+		}
+	})
+}
+
+func TestUnknownProviderMoveResourceState(t *testing.T) {
+	t.Run("delegates to the unconfigured client and reports the moved state", func(t *testing.T) {
+		unconfiguredClient := &testing_provider.MockProvider{
+			MoveResourceStateFn: func(req providers.MoveResourceStateRequest) providers.MoveResourceStateResponse {
+				return providers.MoveResourceStateResponse{
+					TargetState: cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("moved")}),
+				}
+			},
+		}
+		provider := UnknownProvider(unconfiguredClient, "")
+
+		resp := provider.MoveResourceState(providers.MoveResourceStateRequest{
+			SourceTypeName: "test_thing",
+		})
+		if resp.Diagnostics.HasErrors() {
+			t.Fatalf("unexpected errors: %s", resp.Diagnostics.Err())
+		}
+		want := cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("moved")})
+		if !resp.TargetState.RawEquals(want) {
+			t.Fatalf("wrong target state: got %#v, want %#v", resp.TargetState, want)
+		}
+		if !unconfiguredClient.MoveResourceStateCalled {
+			t.Fatal("expected the call to be delegated to the unconfigured client")
+		}
+	})
+
+	t.Run("errors clearly when the unconfigured client doesn't implement a meaningful move", func(t *testing.T) {
+		unconfiguredClient := &testing_provider.MockProvider{}
+		provider := UnknownProvider(unconfiguredClient, "")
+
+		resp := provider.MoveResourceState(providers.MoveResourceStateRequest{
+			SourceTypeName: "test_thing",
+		})
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected an error, but there was none")
+		}
+	})
+}
+
+func TestUnknownProviderApplyResourceChangeNeverSucceeds(t *testing.T) {
+	// Since apply can never succeed for a resource whose provider
+	// configuration is unknown, Core never gets as far as evaluating that
+	// resource's postconditions against an applied result.
+	unconfiguredClient := &testing_provider.MockProvider{}
+	provider := UnknownProvider(unconfiguredClient, "")
+
+	resp := provider.ApplyResourceChange(providers.ApplyResourceChangeRequest{
+		TypeName: "test_thing",
+	})
+	if !resp.Diagnostics.HasErrors() {
+		t.Fatal("expected an error, but there was none")
+	}
+}
+
+func TestUnknownProviderStopForwardsToUnconfiguredClient(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{}
+	provider := UnknownProvider(unconfiguredClient, "")
+
+	if err := provider.Stop(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !unconfiguredClient.StopCalled {
+		t.Fatal("expected Stop to be forwarded to the unconfigured client")
+	}
+}
+
+// countingIdentitySchemasProvider wraps a MockProvider to count how many
+// times GetResourceIdentitySchemas actually reaches it, since MockProvider
+// itself only tracks whether it was called at all.
+type countingIdentitySchemasProvider struct {
+	*testing_provider.MockProvider
+	identitySchemasCalls int
+}
+
+func (p *countingIdentitySchemasProvider) GetResourceIdentitySchemas() providers.GetResourceIdentitySchemasResponse {
+	p.identitySchemasCalls++
+	return p.MockProvider.GetResourceIdentitySchemas()
+}
+
+func TestUnknownProviderGetResourceIdentitySchemasIsMemoized(t *testing.T) {
+	unconfiguredClient := &countingIdentitySchemasProvider{MockProvider: &testing_provider.MockProvider{}}
+	provider := UnknownProvider(unconfiguredClient, "")
+
+	for i := 0; i < 5; i++ {
+		provider.GetResourceIdentitySchemas()
+	}
+
+	if unconfiguredClient.identitySchemasCalls != 1 {
+		t.Fatalf("expected the unconfigured client to be called once, got %d calls", unconfiguredClient.identitySchemasCalls)
+	}
+}
+
+func BenchmarkUnknownProviderGetResourceIdentitySchemas(b *testing.B) {
+	unconfiguredClient := &testing_provider.MockProvider{}
+	provider := UnknownProvider(unconfiguredClient, "")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		provider.GetResourceIdentitySchemas()
+	}
+}
+
+func TestUnknownProviderValidateEphemeralResourceConfig(t *testing.T) {
+	t.Run("delegates to the unconfigured client and reports config errors", func(t *testing.T) {
+		unconfiguredClient := &testing_provider.MockProvider{
+			ValidateEphemeralResourceConfigFn: func(req providers.ValidateEphemeralResourceConfigRequest) providers.ValidateEphemeralResourceConfigResponse {
+				var diags tfdiags.Diagnostics
+				diags = diags.Append(fmt.Errorf("missing required attribute \"name\""))
+				return providers.ValidateEphemeralResourceConfigResponse{
+					Diagnostics: diags,
+				}
+			},
+		}
+		provider := UnknownProvider(unconfiguredClient, "")
+
+		resp := provider.ValidateEphemeralResourceConfig(providers.ValidateEphemeralResourceConfigRequest{
+			TypeName: "test_thing",
+			Config:   cty.EmptyObjectVal,
+		})
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected the config error from the unconfigured client to be reported")
+		}
+	})
+
+	t.Run("succeeds offline when the config is valid", func(t *testing.T) {
+		unconfiguredClient := &testing_provider.MockProvider{}
+		provider := UnknownProvider(unconfiguredClient, "")
+
+		resp := provider.ValidateEphemeralResourceConfig(providers.ValidateEphemeralResourceConfigRequest{
+			TypeName: "test_thing",
+			Config:   cty.EmptyObjectVal,
+		})
+		if resp.Diagnostics.HasErrors() {
+			t.Fatalf("unexpected errors: %s", resp.Diagnostics.Err())
+		}
+		if !unconfiguredClient.ValidateEphemeralResourceConfigCalled {
+			t.Fatal("expected the call to be delegated to the unconfigured client")
+		}
+	})
+}
+
+func TestUnknownProviderReadResourceIgnoresConsistencyLevel(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{}
+	provider := UnknownProvider(unconfiguredClient, "")
+
+	resp := provider.ReadResource(providers.ReadResourceRequest{
+		TypeName: "test_thing",
+		ClientCapabilities: providers.ClientCapabilities{
+			DeferralAllowed: true,
+		},
+		PriorState:       cty.StringVal("existing"),
+		ConsistencyLevel: providers.ConsistencyLevelStrong,
+	})
+	if resp.Diagnostics.HasErrors() {
+		t.Fatalf("unexpected errors: %s", resp.Diagnostics.Err())
+	}
+	if resp.Deferred == nil {
+		t.Fatal("expected the read to be deferred")
+	}
+	if !resp.NewState.RawEquals(cty.StringVal("existing")) {
+		t.Fatalf("wrong new state: %#v", resp.NewState)
+	}
+}
+
+func TestUnknownProviderDeferralReason(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{}
+
+	t.Run("defaults to provider config unknown", func(t *testing.T) {
+		provider := UnknownProvider(unconfiguredClient, "")
+
+		resp := provider.ReadResource(providers.ReadResourceRequest{
+			TypeName: "test_thing",
+			ClientCapabilities: providers.ClientCapabilities{
+				DeferralAllowed: true,
+			},
+			PriorState: cty.StringVal("existing"),
+		})
+		if resp.Deferred == nil {
+			t.Fatal("expected the read to be deferred")
+		}
+		if resp.Deferred.Reason != providers.DeferredReasonProviderConfigUnknown {
+			t.Fatalf("wrong deferred reason: %s", resp.Deferred.Reason)
+		}
+	})
+
+	t.Run("can be overridden with WithDeferralReason", func(t *testing.T) {
+		provider := UnknownProvider(unconfiguredClient, "", WithDeferralReason(providers.DeferredReasonProviderInstancesUnknown))
+
+		resp := provider.ReadResource(providers.ReadResourceRequest{
+			TypeName: "test_thing",
+			ClientCapabilities: providers.ClientCapabilities{
+				DeferralAllowed: true,
+			},
+			PriorState: cty.StringVal("existing"),
+		})
+		if resp.Deferred == nil {
+			t.Fatal("expected the read to be deferred")
+		}
+		if resp.Deferred.Reason != providers.DeferredReasonProviderInstancesUnknown {
+			t.Fatalf("wrong deferred reason: %s", resp.Deferred.Reason)
+		}
+	})
+
+	t.Run("applies to UnknownProviderDeferringFunctions too", func(t *testing.T) {
+		provider := UnknownProviderDeferringFunctions(unconfiguredClient, "", WithDeferralReason(providers.DeferredReasonProviderInstancesUnknown))
+
+		resp := provider.ReadResource(providers.ReadResourceRequest{
+			TypeName: "test_thing",
+			ClientCapabilities: providers.ClientCapabilities{
+				DeferralAllowed: true,
+			},
+			PriorState: cty.StringVal("existing"),
+		})
+		if resp.Deferred == nil {
+			t.Fatal("expected the read to be deferred")
+		}
+		if resp.Deferred.Reason != providers.DeferredReasonProviderInstancesUnknown {
+			t.Fatalf("wrong deferred reason: %s", resp.Deferred.Reason)
+		}
+	})
+}
+
+func TestUnknownProviderSupportsDeferral(t *testing.T) {
+	t.Run("capable provider", func(t *testing.T) {
+		unconfiguredClient := &testing_provider.MockProvider{
+			GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+				ServerCapabilities: providers.ServerCapabilities{
+					DeferralSupported: true,
+				},
+			},
+		}
+		provider := UnknownProvider(unconfiguredClient, "").(*unknownProvider)
+
+		if !provider.supportsDeferral() {
+			t.Fatal("expected the provider to report deferral support")
+		}
+	})
+
+	t.Run("incapable provider", func(t *testing.T) {
+		unconfiguredClient := &testing_provider.MockProvider{
+			GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{},
+		}
+		provider := UnknownProvider(unconfiguredClient, "").(*unknownProvider)
+
+		if provider.supportsDeferral() {
+			t.Fatal("expected the provider to report no deferral support")
+		}
+	})
+}
+
+func TestUnknownProviderDiagnosticsIdentifyTheProvider(t *testing.T) {
+	const label = `provider["registry.terraform.io/hashicorp/aws"].us_east`
+	unconfiguredClient := &testing_provider.MockProvider{}
+	provider := UnknownProvider(unconfiguredClient, label)
+
+	t.Run("ReadResource", func(t *testing.T) {
+		resp := provider.ReadResource(providers.ReadResourceRequest{
+			TypeName: "test_thing",
+		})
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected an error, but there was none")
+		}
+		if !strings.Contains(resp.Diagnostics.Err().Error(), label) {
+			t.Fatalf("expected the diagnostic to mention %q, got: %s", label, resp.Diagnostics.Err())
+		}
+	})
+
+	t.Run("ApplyResourceChange", func(t *testing.T) {
+		resp := provider.ApplyResourceChange(providers.ApplyResourceChangeRequest{
+			TypeName: "test_thing",
+		})
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected an error, but there was none")
+		}
+		if !strings.Contains(resp.Diagnostics.Err().Error(), label) {
+			t.Fatalf("expected the diagnostic to mention %q, got: %s", label, resp.Diagnostics.Err())
+		}
+	})
+
+	t.Run("ValidateStateStoreConfig", func(t *testing.T) {
+		resp := provider.ValidateStateStoreConfig(providers.ValidateStateStoreConfigRequest{
+			TypeName: "test_store",
+		})
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected an error, but there was none")
+		}
+		if !strings.Contains(resp.Diagnostics.Err().Error(), label) {
+			t.Fatalf("expected the diagnostic to mention %q, got: %s", label, resp.Diagnostics.Err())
+		}
+	})
+
+	t.Run("no label means the generic message is unchanged", func(t *testing.T) {
+		provider := UnknownProvider(unconfiguredClient, "")
+		resp := provider.ReadResource(providers.ReadResourceRequest{
+			TypeName: "test_thing",
+		})
+		if strings.Contains(resp.Diagnostics.Err().Error(), " for ") {
+			t.Fatalf("expected no provider reference without a label, got: %s", resp.Diagnostics.Err())
+		}
+	})
+}
+
+func TestUnknownProviderListResource(t *testing.T) {
+	// ListResourceRequest has no ClientCapabilities field and
+	// ListResourceResponse has no Deferred field, so there's no way for
+	// this to behave like the deferral-capable methods above: it always
+	// errors, regardless of what the caller might support.
+	unconfiguredClient := &testing_provider.MockProvider{}
+	provider := UnknownProvider(unconfiguredClient, "")
+
+	resp := provider.ListResource(providers.ListResourceRequest{
+		TypeName: "test_thing",
+	})
+	if !resp.Diagnostics.HasErrors() {
+		t.Fatal("expected an error, but there was none")
+	}
+}
+
+func TestUnknownProviderPlanAction(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{}
+	provider := UnknownProvider(unconfiguredClient, "")
+
+	t.Run("plan is deferred rather than erroring when the client allows it", func(t *testing.T) {
+		resp := provider.PlanAction(providers.PlanActionRequest{
+			ActionType: "test_action",
+			ClientCapabilities: providers.ClientCapabilities{
+				DeferralAllowed: true,
+			},
+		})
+		if resp.Diagnostics.HasErrors() {
+			t.Fatalf("unexpected errors: %s", resp.Diagnostics.Err())
+		}
+		if resp.Deferred == nil {
+			t.Fatal("expected the plan to be deferred, but it was not")
+		}
+		if resp.Deferred.Reason != providers.DeferredReasonProviderConfigUnknown {
+			t.Fatalf("wrong deferred reason: %s", resp.Deferred.Reason)
+		}
+	})
+
+	t.Run("plan errors when the client doesn't support deferral", func(t *testing.T) {
+		resp := provider.PlanAction(providers.PlanActionRequest{
+			ActionType:         "test_action",
+			ClientCapabilities: providers.ClientCapabilities{},
+		})
+		if resp.Deferred != nil {
+			t.Fatal("expected no deferral when the client doesn't support it")
+		}
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected an error, but there was none")
+		}
+	})
+}
+
+func TestUnknownProviderInvokeAction(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{}
+	provider := UnknownProvider(unconfiguredClient, "")
+
+	t.Run("invocation is deferred rather than erroring when the client allows it", func(t *testing.T) {
+		resp := provider.InvokeAction(providers.InvokeActionRequest{
+			ActionType: "test_action",
+			ClientCapabilities: providers.ClientCapabilities{
+				DeferralAllowed: true,
+			},
+		})
+		if resp.Diagnostics.HasErrors() {
+			t.Fatalf("unexpected errors: %s", resp.Diagnostics.Err())
+		}
+		if resp.Deferred == nil {
+			t.Fatal("expected the invocation to be deferred, but it was not")
+		}
+		if resp.Deferred.Reason != providers.DeferredReasonProviderConfigUnknown {
+			t.Fatalf("wrong deferred reason: %s", resp.Deferred.Reason)
+		}
+		if resp.Events != nil {
+			t.Fatal("expected no events to be reported for a deferred invocation")
+		}
+	})
+
+	t.Run("invocation errors when the client doesn't support deferral", func(t *testing.T) {
+		resp := provider.InvokeAction(providers.InvokeActionRequest{
+			ActionType:         "test_action",
+			ClientCapabilities: providers.ClientCapabilities{},
+		})
+		if resp.Deferred != nil {
+			t.Fatal("expected no deferral when the client doesn't support it")
+		}
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected an error, but there was none")
+		}
+	})
+}
+
+func TestUnknownProviderValidateActionConfig(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{
+		GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+			Actions: map[string]providers.ActionSchema{
+				"test_action": {
+					ConfigSchema: &configschema.Block{
+						Attributes: map[string]*configschema.Attribute{
+							"name": {
+								Type:     cty.String,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	provider := UnknownProvider(unconfiguredClient, "")
+
+	t.Run("valid config is delegated to the unconfigured client", func(t *testing.T) {
+		unconfiguredClient.ValidateActionConfigResponse = &providers.ValidateActionConfigResponse{}
+
+		resp := provider.ValidateActionConfig(providers.ValidateActionConfigRequest{
+			TypeName: "test_action",
+			Config: cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("a"),
+			}),
+		})
+		if resp.Diagnostics.HasErrors() {
+			t.Fatalf("unexpected errors: %s", resp.Diagnostics.Err())
+		}
+		if !unconfiguredClient.ValidateActionConfigCalled {
+			t.Fatal("expected the call to be delegated to the unconfigured client")
+		}
+	})
+
+	t.Run("invalid config is delegated to the unconfigured client", func(t *testing.T) {
+		unconfiguredClient.ValidateActionConfigCalled = false
+		unconfiguredClient.ValidateActionConfigResponse = &providers.ValidateActionConfigResponse{
+			Diagnostics: tfdiags.Diagnostics{}.Append(
+				tfdiags.AttributeValue(
+					tfdiags.Error,
+					"Invalid value",
+					"name must not be empty.",
+					cty.GetAttrPath("name"),
+				),
+			),
+		}
+
+		resp := provider.ValidateActionConfig(providers.ValidateActionConfigRequest{
+			TypeName: "test_action",
+			Config: cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal(""),
+			}),
+		})
+		if !unconfiguredClient.ValidateActionConfigCalled {
+			t.Fatal("expected the call to be delegated to the unconfigured client")
+		}
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected the delegated validation error to be returned")
+		}
+		if !strings.Contains(resp.Diagnostics[0].Description().Summary, "Invalid value") {
+			t.Fatalf("expected the delegated error, got: %#v", resp.Diagnostics[0].Description())
+		}
+	})
+
+	t.Run("unknown action type falls back to the provider-configuration-unknown error", func(t *testing.T) {
+		unconfiguredClient.ValidateActionConfigCalled = false
+
+		resp := provider.ValidateActionConfig(providers.ValidateActionConfigRequest{
+			TypeName: "nonexistent_action",
+			Config:   cty.EmptyObjectVal,
+		})
+		if unconfiguredClient.ValidateActionConfigCalled {
+			t.Fatal("expected the call not to be delegated for an unknown action type")
+		}
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected an error, but there was none")
+		}
+		if !strings.Contains(resp.Diagnostics[0].Description().Summary, "unknown") {
+			t.Fatalf("expected the provider-configuration-unknown error, got: %#v", resp.Diagnostics[0].Description())
+		}
+	})
+}
+
+func TestUnknownProviderImportResourceState(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{
+		GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+			ResourceTypes: map[string]providers.Schema{
+				"test_thing": {
+					Body: &configschema.Block{
+						Attributes: map[string]*configschema.Attribute{
+							"id": {
+								Type:     cty.String,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		GetResourceIdentitySchemasResponse: &providers.GetResourceIdentitySchemasResponse{
+			IdentityTypes: map[string]providers.IdentitySchema{
+				"test_thing": {
+					Body: &configschema.Object{
+						Attributes: map[string]*configschema.Attribute{
+							"id": {
+								Type:     cty.String,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	provider := UnknownProvider(unconfiguredClient, "")
+
+	resp := provider.ImportResourceState(providers.ImportResourceStateRequest{
+		TypeName: "test_thing",
+		ID:       "anything",
+		ClientCapabilities: providers.ClientCapabilities{
+			DeferralAllowed: true,
+		},
+	})
+	if resp.Diagnostics.HasErrors() {
+		t.Fatalf("unexpected errors: %s", resp.Diagnostics.Err())
+	}
+	if resp.Deferred == nil {
+		t.Fatal("expected the import to be deferred")
+	}
+	if len(resp.ImportedResources) != 1 {
+		t.Fatalf("expected exactly one imported resource, got %d", len(resp.ImportedResources))
+	}
+
+	imported := resp.ImportedResources[0]
+	if imported.State.IsKnown() {
+		t.Fatalf("expected unknown state, got known value: %#v", imported.State)
+	}
+	wantStateType := cty.Object(map[string]cty.Type{"id": cty.String})
+	if got, want := imported.State.Type(), wantStateType; !got.Equals(want) {
+		t.Fatalf("wrong state type\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	if imported.Identity.IsKnown() {
+		t.Fatalf("expected unknown identity, got known value: %#v", imported.Identity)
+	}
+	wantIdentityType := cty.Object(map[string]cty.Type{"id": cty.String})
+	if got, want := imported.Identity.Type(), wantIdentityType; !got.Equals(want) {
+		t.Fatalf("wrong identity type\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestUnknownProviderImportResourceStateNoIdentitySchema(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{
+		GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+			ResourceTypes: map[string]providers.Schema{
+				"test_thing": {
+					Body: &configschema.Block{
+						Attributes: map[string]*configschema.Attribute{
+							"id": {
+								Type:     cty.String,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	provider := UnknownProvider(unconfiguredClient, "")
+
+	resp := provider.ImportResourceState(providers.ImportResourceStateRequest{
+		TypeName: "test_thing",
+		ID:       "anything",
+		ClientCapabilities: providers.ClientCapabilities{
+			DeferralAllowed: true,
+		},
+	})
+	if resp.Diagnostics.HasErrors() {
+		t.Fatalf("unexpected errors: %s", resp.Diagnostics.Err())
+	}
+	if len(resp.ImportedResources) != 1 {
+		t.Fatalf("expected exactly one imported resource, got %d", len(resp.ImportedResources))
+	}
+	if resp.ImportedResources[0].Identity != cty.NilVal {
+		t.Fatalf("expected no identity value when the resource type has no identity schema, got: %#v", resp.ImportedResources[0].Identity)
+	}
+}
+
+func TestUnknownProviderReadResourcesBatch(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{}
+	provider := UnknownProvider(unconfiguredClient, "")
+
+	batchReader, ok := provider.(providers.BatchReader)
+	if !ok {
+		t.Fatal("unknownProvider does not implement providers.BatchReader")
+	}
+
+	requests := []providers.ReadResourceRequest{
+		{
+			TypeName:   "test_thing",
+			PriorState: cty.StringVal("a"),
+			ClientCapabilities: providers.ClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+		{
+			TypeName:   "test_thing",
+			PriorState: cty.StringVal("b"),
+			ClientCapabilities: providers.ClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+		{
+			TypeName:   "test_thing",
+			PriorState: cty.StringVal("c"),
+			// DeferralAllowed left false, to exercise the error path too.
+		},
+	}
+
+	batchResponses := batchReader.ReadResources(requests)
+	if len(batchResponses) != len(requests) {
+		t.Fatalf("expected %d responses, got %d", len(requests), len(batchResponses))
+	}
+
+	for i, request := range requests {
+		singleResp := provider.ReadResource(request)
+		batchResp := batchResponses[i]
+
+		if got, want := batchResp.NewState, singleResp.NewState; !got.RawEquals(want) {
+			t.Errorf("response %d: wrong state\ngot:  %#v\nwant: %#v", i, got, want)
+		}
+		if got, want := batchResp.Diagnostics.HasErrors(), singleResp.Diagnostics.HasErrors(); got != want {
+			t.Errorf("response %d: diagnostics HasErrors mismatch: got %v, want %v", i, got, want)
+		}
+		if (batchResp.Deferred == nil) != (singleResp.Deferred == nil) {
+			t.Errorf("response %d: deferred mismatch: got %#v, want %#v", i, batchResp.Deferred, singleResp.Deferred)
+		}
+	}
+
+	// The two deferred responses should share the same *providers.Deferred
+	// value, since the batch path only needs to make that decision once.
+	if batchResponses[0].Deferred == nil || batchResponses[1].Deferred == nil {
+		t.Fatal("expected both deferrable requests to be deferred")
+	}
+	if batchResponses[0].Deferred != batchResponses[1].Deferred {
+		t.Error("expected the batch path to reuse the same *providers.Deferred value across requests")
+	}
+}
+
+func TestUnknownProviderReadDataSourceCache(t *testing.T) {
+	schema := providers.Schema{
+		Body: &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"name": {
+					Type:     cty.String,
+					Optional: true,
+				},
+				"result": {
+					Type:     cty.String,
+					Computed: true,
+				},
+			},
+		},
+	}
+	unconfiguredClient := &testing_provider.MockProvider{
+		GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+			DataSources: map[string]providers.Schema{
+				"test_thing": schema,
+			},
+		},
+	}
+
+	knownConfig := cty.ObjectVal(map[string]cty.Value{
+		"name":   cty.StringVal("a"),
+		"result": cty.NullVal(cty.String),
+	})
+	otherConfig := cty.ObjectVal(map[string]cty.Value{
+		"name":   cty.StringVal("b"),
+		"result": cty.NullVal(cty.String),
+	})
+
+	t.Run("cache miss computes and records a result", func(t *testing.T) {
+		cache := NewDataSourceReadCache()
+		provider := UnknownProvider(unconfiguredClient, "", WithDataSourceReadCache(cache))
+
+		resp := provider.ReadDataSource(providers.ReadDataSourceRequest{
+			TypeName: "test_thing",
+			Config:   knownConfig,
+			ClientCapabilities: providers.ClientCapabilities{
+				DeferralAllowed: true,
+			},
+		})
+		if resp.Diagnostics.HasErrors() {
+			t.Fatalf("unexpected errors: %s", resp.Diagnostics.Err())
+		}
+		if resp.Deferred == nil {
+			t.Fatal("expected the first read to be deferred")
+		}
+		if _, ok := cache.lookup("test_thing", knownConfig); !ok {
+			t.Fatal("expected the result to be recorded in the cache")
+		}
+	})
+
+	t.Run("cache hit replays the recorded deferral", func(t *testing.T) {
+		cache := NewDataSourceReadCache()
+		provider := UnknownProvider(unconfiguredClient, "", WithDataSourceReadCache(cache))
+		request := providers.ReadDataSourceRequest{
+			TypeName: "test_thing",
+			Config:   knownConfig,
+			ClientCapabilities: providers.ClientCapabilities{
+				DeferralAllowed: true,
+			},
+		}
+
+		first := provider.ReadDataSource(request)
+		second := provider.ReadDataSource(request)
+
+		if !first.State.RawEquals(second.State) {
+			t.Fatalf("expected the same state from cache, got %#v and %#v", first.State, second.State)
+		}
+		if second.Deferred == nil {
+			t.Fatal("expected the cached result to carry the same deferral as the original read")
+		}
+		if first.Deferred.Reason != second.Deferred.Reason {
+			t.Fatalf("expected the cached deferral reason to match the original, got %#v and %#v", first.Deferred, second.Deferred)
+		}
+	})
+
+	t.Run("a different configuration invalidates the cache", func(t *testing.T) {
+		cache := NewDataSourceReadCache()
+		provider := UnknownProvider(unconfiguredClient, "", WithDataSourceReadCache(cache))
+
+		provider.ReadDataSource(providers.ReadDataSourceRequest{
+			TypeName: "test_thing",
+			Config:   knownConfig,
+			ClientCapabilities: providers.ClientCapabilities{
+				DeferralAllowed: true,
+			},
+		})
+		resp := provider.ReadDataSource(providers.ReadDataSourceRequest{
+			TypeName: "test_thing",
+			Config:   otherConfig,
+			ClientCapabilities: providers.ClientCapabilities{
+				DeferralAllowed: true,
+			},
+		})
+		if resp.Deferred == nil {
+			t.Fatal("expected a changed configuration to miss the cache and be deferred again")
+		}
+		if _, ok := cache.lookup("test_thing", otherConfig); !ok {
+			t.Fatal("expected the new configuration's result to be recorded separately")
+		}
+	})
+
+	t.Run("no cache set means every read is computed fresh", func(t *testing.T) {
+		provider := UnknownProvider(unconfiguredClient, "")
+		request := providers.ReadDataSourceRequest{
+			TypeName: "test_thing",
+			Config:   knownConfig,
+			ClientCapabilities: providers.ClientCapabilities{
+				DeferralAllowed: true,
+			},
+		}
+
+		resp := provider.ReadDataSource(request)
+		if resp.Deferred == nil {
+			t.Fatal("expected the read to be deferred when no cache is configured")
+		}
+	})
+}
+
+func BenchmarkUnknownProviderReadResourceOneAtATime(b *testing.B) {
+	unconfiguredClient := &testing_provider.MockProvider{}
+	provider := UnknownProvider(unconfiguredClient, "")
+	request := providers.ReadResourceRequest{
+		TypeName:   "test_thing",
+		PriorState: cty.StringVal("a"),
+		ClientCapabilities: providers.ClientCapabilities{
+			DeferralAllowed: true,
+		},
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 100; j++ {
+			provider.ReadResource(request)
+		}
+	}
+}
+
+func TestUnknownProviderCallFunctionBatch(t *testing.T) {
+	unconfiguredClient := &testing_provider.MockProvider{
+		GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+			Functions: map[string]providers.FunctionDecl{
+				"greet": {
+					ReturnType: cty.String,
+				},
+			},
+		},
+	}
+	provider := UnknownProviderDeferringFunctions(unconfiguredClient, "")
+
+	batchCaller, ok := provider.(providers.BatchFunctionCaller)
+	if !ok {
+		t.Fatal("unknownProvider does not implement providers.BatchFunctionCaller")
+	}
+
+	request := providers.CallFunctionRequest{
+		FunctionName: "greet",
+		Arguments:    []cty.Value{cty.StringVal("world")},
+	}
+	requests := []providers.CallFunctionRequest{request, request}
+
+	batchResponses := batchCaller.CallFunctionBatch(requests)
+	if len(batchResponses) != len(requests) {
+		t.Fatalf("expected %d responses, got %d", len(requests), len(batchResponses))
+	}
+
+	singleResp := provider.CallFunction(request)
+	for i, resp := range batchResponses {
+		if resp.Err != nil {
+			t.Fatalf("response %d: unexpected error: %s", i, resp.Err)
+		}
+		if got, want := resp.Result.Type(), singleResp.Result.Type(); !got.Equals(want) {
+			t.Errorf("response %d: wrong result type: got %#v, want %#v", i, got, want)
+		}
+	}
+
+	// Driving the same requests through the generic CallFunctionBatch
+	// dispatcher should use this batch implementation rather than falling
+	// back to one CallFunction call per request.
+	dispatched := providers.CallFunctionBatch(provider, requests)
+	if len(dispatched) != len(requests) {
+		t.Fatalf("expected %d responses from the dispatcher, got %d", len(requests), len(dispatched))
+	}
+}
+
+func BenchmarkUnknownProviderReadResourcesBatch(b *testing.B) {
+	unconfiguredClient := &testing_provider.MockProvider{}
+	provider := UnknownProvider(unconfiguredClient, "").(providers.BatchReader)
+	request := providers.ReadResourceRequest{
+		TypeName:   "test_thing",
+		PriorState: cty.StringVal("a"),
+		ClientCapabilities: providers.ClientCapabilities{
+			DeferralAllowed: true,
+		},
+	}
+	requests := make([]providers.ReadResourceRequest, 100)
+	for i := range requests {
+		requests[i] = request
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		provider.ReadResources(requests)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/internal/providers"
 	"github.com/hashicorp/terraform/internal/stacks/stackconfig"
 	"github.com/hashicorp/terraform/internal/stacks/stackruntime/internal/stackeval"
+	"github.com/hashicorp/terraform/internal/stacks/stackruntime/internal/stackeval/stubs"
 	"github.com/hashicorp/terraform/internal/tfdiags"
 )
 
@@ -37,6 +38,48 @@ func Validate(ctx context.Context, req *ValidateRequest) tfdiags.Diagnostics {
 	return diags
 }
 
+// ValidateOffline performs the same static validation as Validate, but
+// wraps every provider factory in req.ProviderFactories so that only
+// schema retrieval and config validation calls ever reach the underlying
+// provider; anything that would configure or otherwise make real use of
+// a provider is rejected by the stub instead of being attempted.
+//
+// This allows an operator to dry-run validation across a whole stack
+// configuration -- including components backed by providers that aren't
+// reachable or configured yet, such as cloud credentials that haven't
+// been set up in the current environment -- using the same
+// offline-capable stub that Stacks itself falls back to for providers
+// whose identity is still unknown during a partial plan.
+func ValidateOffline(ctx context.Context, req *ValidateRequest) tfdiags.Diagnostics {
+	offlineFactories := make(map[addrs.Provider]providers.Factory, len(req.ProviderFactories))
+	for provider, factory := range req.ProviderFactories {
+		provider := provider // capture for the closure below
+		factory := factory   // capture for the closure below
+
+		// Every offline stub for this provider shares a single
+		// DataSourceReadCache, since the factory may be called once per
+		// provider configuration instance and each of those can otherwise
+		// end up fabricating the same unknown-valued data source read
+		// result over and over during one ValidateOffline call.
+		dataSourceReadCache := stubs.NewDataSourceReadCache()
+		offlineFactories[provider] = func() (providers.Interface, error) {
+			client, err := factory()
+			if err != nil {
+				return nil, err
+			}
+			return stubs.UnknownProvider(client, provider.String(), stubs.WithDataSourceReadCache(dataSourceReadCache)), nil
+		}
+	}
+
+	offlineReq := &ValidateRequest{
+		Config:             req.Config,
+		ProviderFactories:  offlineFactories,
+		DependencyLocks:    req.DependencyLocks,
+		ExperimentsAllowed: req.ExperimentsAllowed,
+	}
+	return Validate(ctx, offlineReq)
+}
+
 type ValidateRequest struct {
 	Config            *stackconfig.Config
 	ProviderFactories map[addrs.Provider]providers.Factory
@@ -435,6 +435,61 @@ func TestValidate_invalid(t *testing.T) {
 	}
 }
 
+// TestValidateOffline checks that ValidateOffline reports the same
+// diagnostics as Validate for a valid and an invalid configuration, even
+// though it never configures or otherwise makes real use of the
+// underlying provider plugins.
+func TestValidateOffline(t *testing.T) {
+	tcs := map[string]validateTestInput{
+		"empty":                 validConfigurations["empty"],
+		"invalid-configuration": invalidConfigurations["invalid-configuration"],
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			lock := depsfile.NewLocks()
+			lock.SetProvider(
+				addrs.NewDefaultProvider("testing"),
+				providerreqs.MustParseVersion("0.0.0"),
+				providerreqs.MustParseVersionConstraints("=0.0.0"),
+				providerreqs.PreferredHashes([]providerreqs.Hash{}),
+			)
+			lock.SetProvider(
+				addrs.NewDefaultProvider("other"),
+				providerreqs.MustParseVersion("0.0.0"),
+				providerreqs.MustParseVersionConstraints("=0.0.0"),
+				providerreqs.PreferredHashes([]providerreqs.Hash{}),
+			)
+
+			testContext := TestContext{
+				config: loadMainBundleConfigForTest(t, name),
+				providers: map[addrs.Provider]providers.Factory{
+					addrs.NewDefaultProvider("testing"): func() (providers.Interface, error) {
+						return stacks_testing_provider.NewProvider(t), nil
+					},
+					addrs.NewBuiltInProvider("testing"): func() (providers.Interface, error) {
+						return stacks_testing_provider.NewProvider(t), nil
+					},
+					addrs.NewDefaultProvider("other"): func() (providers.Interface, error) {
+						return stacks_testing_provider.NewProvider(t), nil
+					},
+				},
+				dependencyLocks: *lock,
+			}
+
+			var diags tfdiags.Diagnostics
+			if tc.diags != nil {
+				diags = tc.diags()
+			}
+			testContext.ValidateOffline(t, ctx, TestCycle{
+				wantValidateDiags: diags,
+			})
+		})
+	}
+}
+
 func TestValidate(t *testing.T) {
 	tcs := map[string]struct {
 		path      string
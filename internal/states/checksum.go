@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package states
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// Checksum returns a hex-encoded SHA-256 digest of the object's stored
+// attributes, for detecting tampering with a state file between the time
+// Terraform wrote it and the time it is next read.
+//
+// Objects with status ObjectPlanned don't yet have a final remote object
+// associated with them -- they're a placeholder for a deferred or
+// in-progress change -- so there is nothing meaningful to checksum and
+// Checksum returns the empty string for them.
+func (os *ResourceInstanceObjectSrc) Checksum() string {
+	if os.Status == ObjectPlanned {
+		return ""
+	}
+	// The state file writer indents the attributes JSON, so the checksum
+	// covers its compact form and whitespace alone never causes a mismatch.
+	attrs := os.AttrsJSON
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, attrs); err == nil {
+		attrs = compact.Bytes()
+	}
+	h := sha256.Sum256(attrs)
+	return hex.EncodeToString(h[:])
+}
+
+// VerifyChecksum reports whether want matches the checksum the receiver
+// would currently produce via Checksum. An empty want is always treated as
+// verified, since it means no checksum was recorded for this object (for
+// example, because it predates this feature, or because the object was
+// ObjectPlanned at the time the checksum would have been recorded).
+func (os *ResourceInstanceObjectSrc) VerifyChecksum(want string) bool {
+	if want == "" {
+		return true
+	}
+	return os.Checksum() == want
+}
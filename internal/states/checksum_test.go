@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package states
+
+import (
+	"testing"
+)
+
+func TestResourceInstanceObjectSrc_checksum(t *testing.T) {
+	obj := &ResourceInstanceObjectSrc{
+		Status:    ObjectReady,
+		AttrsJSON: []byte(`{"id":"foo"}`),
+	}
+
+	sum := obj.Checksum()
+	if sum == "" {
+		t.Fatalf("expected a non-empty checksum")
+	}
+	if !obj.VerifyChecksum(sum) {
+		t.Errorf("untampered object failed to verify against its own checksum")
+	}
+
+	tampered := &ResourceInstanceObjectSrc{
+		Status:    ObjectReady,
+		AttrsJSON: []byte(`{"id":"bar"}`),
+	}
+	if tampered.VerifyChecksum(sum) {
+		t.Errorf("tampered object incorrectly verified against the original checksum")
+	}
+
+	if !obj.VerifyChecksum("") {
+		t.Errorf("an empty recorded checksum should always verify")
+	}
+}
+
+func TestResourceInstanceObjectSrc_checksumPlanned(t *testing.T) {
+	deferred := &ResourceInstanceObjectSrc{
+		Status:    ObjectPlanned,
+		AttrsJSON: []byte(`{"id":"unknown"}`),
+	}
+	if got := deferred.Checksum(); got != "" {
+		t.Errorf("expected no checksum for a planned object, got %q", got)
+	}
+}
@@ -189,6 +189,18 @@ func prepareStateV4(sV4 *stateV4) (*File, tfdiags.Diagnostics) {
 				}
 			}
 
+			if !obj.VerifyChecksum(isV4.Checksum) {
+				// We keep the object rather than discarding it: the checksum
+				// is a tripwire to help operators notice unexpected state
+				// file edits, not a guarantee that Terraform can only ever
+				// operate on files it wrote verbatim.
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Warning,
+					"Invalid resource instance metadata in state",
+					fmt.Sprintf("Instance %s has attributes that don't match the checksum recorded in the state file, which may indicate the file was corrupted or tampered with after Terraform wrote it.", instAddr.Absolute(moduleAddr)),
+				))
+			}
+
 			if raw := isV4.PrivateRaw; len(raw) > 0 {
 				obj.Private = raw
 			}
@@ -496,6 +508,7 @@ func appendInstanceObjectStateV4(rs *states.Resource, is *states.ResourceInstanc
 		AttributesFlat:          obj.AttrsFlat,
 		AttributesRaw:           obj.AttrsJSON,
 		AttributeSensitivePaths: attributeSensitivePaths,
+		Checksum:                obj.Checksum(),
 		PrivateRaw:              privateRaw,
 		Dependencies:            deps,
 		CreateBeforeDestroy:     obj.CreateBeforeDestroy,
@@ -708,6 +721,7 @@ type instanceObjectStateV4 struct {
 	AttributesRaw           json.RawMessage   `json:"attributes,omitempty"`
 	AttributesFlat          map[string]string `json:"attributes_flat,omitempty"`
 	AttributeSensitivePaths json.RawMessage   `json:"sensitive_attributes,omitempty"`
+	Checksum                string            `json:"checksum,omitempty"`
 
 	IdentitySchemaVersion uint64          `json:"identity_schema_version"`
 	IdentityRaw           json.RawMessage `json:"identity,omitempty"`
@@ -4,12 +4,16 @@
 package statefile
 
 import (
+	"bytes"
+	"encoding/json"
 	"sort"
 	"strings"
 	"testing"
 
 	"github.com/zclconf/go-cty/cty"
 
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/states"
 	"github.com/hashicorp/terraform/internal/tfdiags"
 )
 
@@ -260,3 +264,77 @@ func TestVersion4_marshalPaths(t *testing.T) {
 		})
 	}
 }
+
+// TestVersion4_checksumMismatch verifies that reading a state file whose
+// recorded checksum doesn't match a resource instance's attributes produces
+// a warning, not an error, and that the instance is still present in the
+// resulting state: the checksum is a tripwire for operators, not a gate
+// that can make an otherwise-valid state file unusable.
+func TestVersion4_checksumMismatch(t *testing.T) {
+	state := states.NewState()
+	state.RootModule().SetResourceInstanceCurrent(
+		addrs.Resource{
+			Mode: addrs.ManagedResourceMode,
+			Type: "test_thing",
+			Name: "baz",
+		}.Instance(addrs.NoKey),
+		&states.ResourceInstanceObjectSrc{
+			Status:        states.ObjectReady,
+			SchemaVersion: 1,
+			AttrsJSON:     []byte(`{"id":"original"}`),
+		},
+		addrs.AbsProviderConfig{
+			Provider: addrs.NewDefaultProvider("test"),
+			Module:   addrs.RootModule,
+		},
+	)
+
+	var buf bytes.Buffer
+	diags := writeStateV4(New(state, "boop", 1), &buf)
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	// Tamper with the written attributes without updating the checksum that
+	// was recorded alongside them, simulating a hand-edited state file.
+	var raw map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &raw); err != nil {
+		t.Fatal(err)
+	}
+	resources := raw["resources"].([]interface{})
+	instances := resources[0].(map[string]interface{})["instances"].([]interface{})
+	instance := instances[0].(map[string]interface{})
+	instance["attributes"] = json.RawMessage(`{"id":"tampered"}`)
+	tampered, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file, diags := readStateV4(tampered)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	var foundWarning bool
+	for _, diag := range diags {
+		if diag.Severity() == tfdiags.Warning && strings.Contains(diag.Description().Summary, "Invalid resource instance metadata") {
+			foundWarning = true
+		}
+	}
+	if !foundWarning {
+		t.Fatalf("expected a checksum mismatch warning, got: %#v", diags)
+	}
+
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_thing",
+		Name: "baz",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	is := file.State.ResourceInstance(addr)
+	if is == nil || is.Current == nil {
+		t.Fatal("tampered instance was dropped from the resulting state")
+	}
+	if got, want := string(is.Current.AttrsJSON), `{"id":"tampered"}`; got != want {
+		t.Fatalf("wrong attributes: got %s, want %s", got, want)
+	}
+}
@@ -129,6 +129,17 @@ func (n *actionTriggerApplyInstance) Invoke(ctx EvalContext, caller addrs.Refere
 		return diags
 	}
 
+	if resp.Deferred != nil {
+		// The provider wasn't able to invoke the action at all, so there
+		// are no events or side effects to report. We complete the hook
+		// without an error so apply-time orchestration can move on cleanly,
+		// rather than treating this the same as an invocation failure.
+		diags = diags.Append(ctx.Hook(func(h Hook) (HookAction, error) {
+			return h.CompleteAction(hookIdentity, nil)
+		}))
+		return diags
+	}
+
 	if resp.Events != nil {
 		for event := range resp.Events {
 			switch ev := event.(type) {
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/states"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// DependencyStatus describes the outcome of re-checking a single planned
+// dependency against the state Terraform is about to apply against.
+type DependencyStatus int
+
+const (
+	// DependencyOK means the dependency is still present in state, so the
+	// planned change that relied on it can proceed.
+	DependencyOK DependencyStatus = iota
+
+	// DependencyDeferred means the dependency's provider configuration is
+	// currently unknown, so Terraform can't yet tell whether the dependency
+	// still holds.
+	DependencyDeferred
+
+	// DependencyMissing means the dependency is no longer present in state
+	// at all, so the plan that relied on it is stale.
+	DependencyMissing
+)
+
+// revalidatePlannedDependencies re-checks, immediately before apply, that
+// every resource a planned change depended on during planning is still
+// present in the given state.
+//
+// State can change out from under a plan between the time it was created
+// and the time it's applied -- for example, a concurrent run may have
+// destroyed one of the resources a change depends on -- so applying the
+// plan as though nothing had changed could silently act on stale
+// assumptions. This re-check gives the apply step a chance to defer or
+// error instead.
+//
+// isUnknown reports whether a dependency's provider configuration is
+// currently unknown. A dependency in that state is reported as deferred
+// rather than missing, mirroring how the stacks runtime's unknownProvider
+// stub defers rather than errors when it can't yet tell what a resource
+// looks like. isUnknown may be nil, in which case no dependency is ever
+// considered deferred.
+func revalidatePlannedDependencies(deps []addrs.ConfigResource, state *states.State, isUnknown func(addrs.ConfigResource) bool) (map[string]DependencyStatus, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	statuses := make(map[string]DependencyStatus, len(deps))
+
+	for _, dep := range deps {
+		if isUnknown != nil && isUnknown(dep) {
+			statuses[dep.String()] = DependencyDeferred
+			continue
+		}
+
+		if len(state.Resources(dep)) == 0 {
+			statuses[dep.String()] = DependencyMissing
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Stale plan",
+				fmt.Sprintf("This plan can no longer be applied because %s, which it depends on, is no longer present in state. Create a new plan from the current state.", dep),
+			))
+			continue
+		}
+
+		statuses[dep.String()] = DependencyOK
+	}
+
+	return statuses, diags
+}
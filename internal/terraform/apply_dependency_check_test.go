@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/states"
+)
+
+func TestRevalidatePlannedDependencies(t *testing.T) {
+	presentAddr := addrs.RootModule.Resource(addrs.ManagedResourceMode, "aws_instance", "present")
+	missingAddr := addrs.RootModule.Resource(addrs.ManagedResourceMode, "aws_instance", "missing")
+	unknownAddr := addrs.RootModule.Resource(addrs.ManagedResourceMode, "aws_instance", "unknown")
+
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			presentAddr.Resource.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				AttrsJSON: []byte(`{"id":"present"}`),
+				Status:    states.ObjectReady,
+			},
+			addrs.AbsProviderConfig{
+				Provider: addrs.NewDefaultProvider("aws"),
+				Module:   addrs.RootModule,
+			},
+		)
+	})
+
+	statuses, diags := revalidatePlannedDependencies(
+		[]addrs.ConfigResource{presentAddr, missingAddr, unknownAddr},
+		state,
+		func(dep addrs.ConfigResource) bool {
+			return dep.Equal(unknownAddr)
+		},
+	)
+
+	if statuses[presentAddr.String()] != DependencyOK {
+		t.Fatalf("expected %s to be OK, got %v", presentAddr, statuses[presentAddr.String()])
+	}
+	if statuses[unknownAddr.String()] != DependencyDeferred {
+		t.Fatalf("expected %s to be deferred, got %v", unknownAddr, statuses[unknownAddr.String()])
+	}
+	if statuses[missingAddr.String()] != DependencyMissing {
+		t.Fatalf("expected %s to be missing, got %v", missingAddr, statuses[missingAddr.String()])
+	}
+
+	if !diags.HasErrors() {
+		t.Fatal("expected an error diagnostic for the missing dependency")
+	}
+	if len(diags) != 1 {
+		t.Fatalf("expected exactly one diagnostic, got %d: %s", len(diags), diags.Err())
+	}
+}
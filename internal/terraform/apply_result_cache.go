@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/plans"
+	"github.com/hashicorp/terraform/internal/providers"
+)
+
+// graphNodeApplyResultCacheConsumer is implemented by graph nodes that can
+// consult a shared applyResultCache during apply, if the running operation
+// requested one via ContextOpts.ReuseIdempotentApplyResults.
+type graphNodeApplyResultCacheConsumer interface {
+	SetApplyResultCache(cache *applyResultCache)
+}
+
+// applyResultCache is an opt-in, in-memory cache of provider apply results,
+// keyed by resource instance address together with the exact before/after
+// values of the change that was applied.
+//
+// Core's ordinary apply walk applies each resource instance's change exactly
+// once per operation, and a truly no-op change already skips the provider
+// entirely in NodeAbstractResourceInstance.apply, so this cache has nothing
+// to do in that common case. It exists for the narrower case of a caller
+// that evaluates apply for the same instance and change more than once
+// within a single operation -- for example, re-running part of the graph
+// during testing or tooling built on Core -- where repeating an identical
+// apply against an idempotent provider wastes a round-trip that's
+// guaranteed to produce the same result. A NodeAbstractResourceInstance only
+// consults this cache if one has been explicitly attached to it; by default
+// there is none, and every apply goes to the provider as usual.
+type applyResultCache struct {
+	mu      sync.Mutex
+	results map[applyResultCacheKey]providers.ApplyResourceChangeResponse
+}
+
+type applyResultCacheKey struct {
+	addr   string
+	before string
+	after  string
+}
+
+// newApplyResultCache returns an empty applyResultCache, ready to use.
+func newApplyResultCache() *applyResultCache {
+	return &applyResultCache{
+		results: make(map[applyResultCacheKey]providers.ApplyResourceChangeResponse),
+	}
+}
+
+func (c *applyResultCache) key(addr addrs.AbsResourceInstance, change *plans.ResourceInstanceChange) applyResultCacheKey {
+	return applyResultCacheKey{
+		addr:   addr.String(),
+		before: change.Before.GoString(),
+		after:  change.After.GoString(),
+	}
+}
+
+// get returns the cached response for applying change to addr, if any.
+func (c *applyResultCache) get(addr addrs.AbsResourceInstance, change *plans.ResourceInstanceChange) (providers.ApplyResourceChangeResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	resp, ok := c.results[c.key(addr, change)]
+	return resp, ok
+}
+
+// put records resp as the result of applying change to addr, so that a
+// later, identical apply can reuse it instead of calling the provider
+// again.
+func (c *applyResultCache) put(addr addrs.AbsResourceInstance, change *plans.ResourceInstanceChange, resp providers.ApplyResourceChangeResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.results[c.key(addr, change)] = resp
+}
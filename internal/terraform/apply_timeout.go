@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// applyResourceChangeWithTimeout calls provider.ApplyResourceChange and, if
+// the call has not returned within timeout, appends a warning diagnostic
+// noting that the apply ran long instead of discarding whatever the
+// provider eventually reports.
+//
+// The provider protocol has no way for Terraform to interrupt a call that's
+// already in flight, so a timeout here can't cancel the underlying
+// operation early. What it does do is let the caller stop treating a slow
+// apply as a silent success or a silent failure: the returned response is
+// always the one the provider actually produced, including any partial
+// NewState it set before running into trouble, and TimedOut reports
+// whether that response arrived after the deadline.
+//
+// Callers should not wrap calls to unknownProvider (see the stacks stubs
+// package) in this helper: that stub answers ApplyResourceChange
+// synchronously with a fixed error response, so there is no slow call to
+// bound and nothing to gain from timing it.
+func applyResourceChangeWithTimeout(provider providers.Interface, req providers.ApplyResourceChangeRequest, timeout time.Duration) (resp providers.ApplyResourceChangeResponse, timedOut bool) {
+	done := make(chan providers.ApplyResourceChangeResponse, 1)
+	go func() {
+		done <- provider.ApplyResourceChange(req)
+	}()
+
+	select {
+	case resp = <-done:
+		return resp, false
+	case <-time.After(timeout):
+		resp = <-done
+		resp.Diagnostics = resp.Diagnostics.Append(tfdiags.Sourceless(
+			tfdiags.Warning,
+			"Resource apply exceeded expected timeout",
+			fmt.Sprintf("Applying changes for this resource took longer than the configured timeout of %s. Terraform has recorded whatever state the provider reported, but you may want to verify the result out of band.", timeout),
+		))
+		return resp, true
+	}
+}
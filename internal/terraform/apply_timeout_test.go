@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/providers"
+	testing_provider "github.com/hashicorp/terraform/internal/providers/testing"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+func TestApplyResourceChangeWithTimeout(t *testing.T) {
+	t.Run("returns promptly when the provider is fast enough", func(t *testing.T) {
+		provider := &testing_provider.MockProvider{
+			ConfigureProviderCalled: true,
+			ApplyResourceChangeFn: func(providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+				return providers.ApplyResourceChangeResponse{
+					NewState: cty.StringVal("done"),
+				}
+			},
+		}
+
+		resp, timedOut := applyResourceChangeWithTimeout(provider, providers.ApplyResourceChangeRequest{}, time.Second)
+		if timedOut {
+			t.Fatal("expected timedOut to be false")
+		}
+		if resp.Diagnostics.HasErrors() {
+			t.Fatalf("unexpected errors: %s", resp.Diagnostics.Err())
+		}
+		if len(resp.Diagnostics) != 0 {
+			t.Fatalf("expected no diagnostics, got %d", len(resp.Diagnostics))
+		}
+		if resp.NewState != cty.StringVal("done") {
+			t.Fatalf("wrong new state: %#v", resp.NewState)
+		}
+	})
+
+	t.Run("captures partial progress reported after the timeout", func(t *testing.T) {
+		provider := &testing_provider.MockProvider{
+			ConfigureProviderCalled: true,
+			ApplyResourceChangeFn: func(providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+				time.Sleep(50 * time.Millisecond)
+				return providers.ApplyResourceChangeResponse{
+					NewState: cty.StringVal("partially-applied"),
+				}
+			},
+		}
+
+		resp, timedOut := applyResourceChangeWithTimeout(provider, providers.ApplyResourceChangeRequest{}, time.Millisecond)
+		if !timedOut {
+			t.Fatal("expected timedOut to be true")
+		}
+		if resp.NewState != cty.StringVal("partially-applied") {
+			t.Fatalf("expected the provider's reported state to be preserved, got %#v", resp.NewState)
+		}
+
+		var foundWarning bool
+		for _, diag := range resp.Diagnostics {
+			if diag.Severity() == tfdiags.Warning && diag.Description().Summary == "Resource apply exceeded expected timeout" {
+				foundWarning = true
+			}
+		}
+		if !foundWarning {
+			t.Fatalf("expected a timeout warning diagnostic, got %#v", resp.Diagnostics)
+		}
+	})
+}
@@ -63,6 +63,23 @@ type ContextOpts struct {
 	TracingContext context.Context
 
 	UIInput UIInput
+
+	// ReuseIdempotentApplyResults opts into caching each resource instance's
+	// apply result for the duration of the operation, keyed by the exact
+	// change applied, so that a caller who evaluates apply for the same
+	// instance and change more than once within a single operation -- for
+	// example, re-running part of the graph during testing or tooling built
+	// on Core -- can skip a redundant round-trip to an idempotent provider.
+	// Core's own apply walk never needs this, since it applies each instance
+	// exactly once, so it defaults to off.
+	ReuseIdempotentApplyResults bool
+
+	// ProviderApplyRateLimits, if set, spaces out apply operations against
+	// each named provider at the given applies-per-second rate. Providers
+	// with no entry here are never delayed. This is intended for callers
+	// that know a provider's remote API enforces a rate limit that the
+	// provider itself doesn't protect against.
+	ProviderApplyRateLimits map[addrs.Provider]float64
 }
 
 // ContextMeta is metadata about the running context. This is information
@@ -104,7 +121,12 @@ type Context struct {
 
 	l                   sync.Mutex // Lock acquired during any task
 	parallelSem         Semaphore
+	applySerialSem      Semaphore
+	forEachSemaphores   *ForEachSemaphorePool
+	applyResultCache    *applyResultCache
+	providerRateLimiter *ProviderApplyRateLimiter
 	providerInputConfig map[string]map[string]cty.Value
+	providerDefaultTags map[string]cty.Value
 	runCond             *sync.Cond
 	runContext          context.Context
 	runContextCancel    context.CancelFunc
@@ -154,6 +176,16 @@ func NewContext(opts *ContextOpts) (*Context, tfdiags.Diagnostics) {
 
 	plugins := newContextPlugins(opts.Providers, opts.Provisioners, opts.PreloadedProviderSchemas)
 
+	var resultCache *applyResultCache
+	if opts.ReuseIdempotentApplyResults {
+		resultCache = newApplyResultCache()
+	}
+
+	var rateLimiter *ProviderApplyRateLimiter
+	if len(opts.ProviderApplyRateLimits) > 0 {
+		rateLimiter = NewProviderApplyRateLimiter(opts.ProviderApplyRateLimits)
+	}
+
 	log.Printf("[TRACE] terraform.NewContext: complete")
 
 	return &Context{
@@ -165,7 +197,12 @@ func NewContext(opts *ContextOpts) (*Context, tfdiags.Diagnostics) {
 		plugins: plugins,
 
 		parallelSem:         NewSemaphore(par),
+		applySerialSem:      NewSemaphore(1),
+		forEachSemaphores:   NewForEachSemaphorePool(),
+		applyResultCache:    resultCache,
+		providerRateLimiter: rateLimiter,
 		providerInputConfig: make(map[string]map[string]cty.Value),
+		providerDefaultTags: make(map[string]cty.Value),
 		sh:                  sh,
 		tracingCtx:          opts.TracingContext,
 	}, diags
@@ -178,6 +178,60 @@ resource "test_object" "a" {
 			expectInvokeActionCalled: true,
 		},
 
+		"before and after update triggered": {
+			module: map[string]string{
+				"main.tf": `
+action "action_example" "hello" {}
+resource "test_object" "a" {
+  test_string = "new name"
+  lifecycle {
+    action_trigger {
+      events = [before_update,after_update]
+      actions = [action.action_example.hello]
+    }
+  }
+}
+`,
+			},
+			prevRunState: states.BuildState(func(s *states.SyncState) {
+				s.SetResourceInstanceCurrent(mustResourceInstanceAddr("test_object.a"),
+					&states.ResourceInstanceObjectSrc{
+						Status:    states.ObjectReady,
+						AttrsJSON: []byte(`{"test_string":"old name"}`),
+					},
+					mustProviderConfig(`provider["registry.terraform.io/hashicorp/test"]`),
+				)
+			}),
+			expectInvokeActionCalled: true,
+			assertHooks: func(t *testing.T, capture actionHookCapture) {
+				if len(capture.startActionHooks) != 2 {
+					t.Error("expected 2 start action hooks")
+				}
+				if len(capture.completeActionHooks) != 2 {
+					t.Error("expected 2 complete action hooks")
+				}
+
+				evaluateHook := func(got HookActionIdentity, wantAddr string, wantEvent configs.ActionTriggerEvent) {
+					trigger := got.ActionTrigger.(*plans.ResourceActionTrigger)
+
+					if trigger.ActionTriggerEvent != wantEvent {
+						t.Errorf("wrong event, got %s, want %s", trigger.ActionTriggerEvent, wantEvent)
+					}
+					if diff := cmp.Diff(got.Addr.String(), wantAddr); len(diff) > 0 {
+						t.Errorf("wrong address: %s", diff)
+					}
+				}
+
+				// the before hook should run before the resource's
+				// ApplyResourceChange, and the after hook afterwards, so the
+				// before event is always captured first.
+				evaluateHook(capture.startActionHooks[0], "action.action_example.hello", configs.BeforeUpdate)
+				evaluateHook(capture.completeActionHooks[0], "action.action_example.hello", configs.BeforeUpdate)
+				evaluateHook(capture.startActionHooks[1], "action.action_example.hello", configs.AfterUpdate)
+				evaluateHook(capture.completeActionHooks[1], "action.action_example.hello", configs.AfterUpdate)
+			},
+		},
+
 		"after_destroy triggered": {
 			module: map[string]string{
 				"main.tf": `
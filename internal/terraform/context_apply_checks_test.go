@@ -707,6 +707,77 @@ check "error" {
 				},
 			},
 		},
+		"cross-resource invariant deferred until apply": {
+			configs: map[string]string{
+				"main.tf": `
+provider "checks" {}
+
+resource "checks_object" "a" {
+  number = 2
+}
+
+resource "checks_object" "b" {}
+
+check "matching_counts" {
+  assert {
+    condition     = checks_object.a.number == checks_object.b.number
+    error_message = "counts don't match"
+  }
+}
+`,
+			},
+			plan: map[string]checksTestingStatus{
+				"matching_counts": {
+					status: checks.StatusUnknown,
+				},
+			},
+			planWarning: "Check block assertion known after apply: The condition could not be evaluated at this time, a result will be known when this plan is applied.",
+			apply: map[string]checksTestingStatus{
+				"matching_counts": {
+					status: checks.StatusPass,
+				},
+			},
+			provider: &testing_provider.MockProvider{
+				Meta: "checks",
+				GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+					ResourceTypes: map[string]providers.Schema{
+						"checks_object": {
+							Body: &configschema.Block{
+								Attributes: map[string]*configschema.Attribute{
+									"number": {
+										Type:     cty.Number,
+										Computed: true,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+				PlanResourceChangeFn: func(request providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+					number := request.ProposedNewState.GetAttr("number")
+					if number.IsNull() {
+						number = cty.UnknownVal(cty.Number)
+					}
+					return providers.PlanResourceChangeResponse{
+						PlannedState: cty.ObjectVal(map[string]cty.Value{
+							"number": number,
+						}),
+					}
+				},
+				ApplyResourceChangeFn: func(request providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+					number := request.PlannedState.GetAttr("number")
+					if !number.IsKnown() {
+						number = cty.NumberIntVal(2)
+					}
+					return providers.ApplyResourceChangeResponse{
+						NewState: cty.ObjectVal(map[string]cty.Value{
+							"number": number,
+						}),
+					}
+				},
+			},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -6,6 +6,8 @@ package terraform
 import (
 	"log"
 
+	"github.com/zclconf/go-cty/cty"
+
 	"github.com/hashicorp/terraform/internal/addrs"
 	"github.com/hashicorp/terraform/internal/configs"
 	"github.com/hashicorp/terraform/internal/states"
@@ -41,6 +43,12 @@ type ImportTarget struct {
 	// LegacyID stores the ID from the command line arguments when using the
 	// import command.
 	LegacyID string
+
+	// LegacyIdentity stores a resource identity value, shaped according to
+	// the target resource type's identity schema, from the command line
+	// arguments when using the import command with -from-file. This is
+	// mutually exclusive with LegacyID: at most one of the two may be set.
+	LegacyIdentity cty.Value
 }
 
 // Import takes already-created external resources and brings them
@@ -1966,6 +1966,80 @@ func TestContext2Plan_crossResourceMoveBasic(t *testing.T) {
 	})
 }
 
+func TestContext2Plan_crossResourceMoveSchemaMismatch(t *testing.T) {
+	addrA := mustResourceInstanceAddr("test_object_one.a")
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+			resource "test_object_two" "a" {
+			}
+
+			moved {
+				from = test_object_one.a
+				to   = test_object_two.a
+			}
+		`,
+	})
+
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(addrA, &states.ResourceInstanceObjectSrc{
+			AttrsJSON: []byte(`{"value":"before"}`),
+			Status:    states.ObjectReady,
+		}, mustProviderConfig(`provider["registry.terraform.io/hashicorp/test"]`))
+	})
+
+	p := &testing_provider.MockProvider{}
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"test_object_one": {
+				Body: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"value": {
+							Type:     cty.String,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"test_object_two": {
+				Body: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"value": {
+							Type:     cty.String,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+		ServerCapabilities: providers.ServerCapabilities{
+			MoveResourceState: true,
+		},
+	}
+	// A buggy provider returns a value that doesn't conform to the target
+	// resource type's schema: "value" should be a string.
+	p.MoveResourceStateResponse = &providers.MoveResourceStateResponse{
+		TargetState: cty.ObjectVal(map[string]cty.Value{
+			"value": cty.NumberIntVal(1),
+		}),
+	}
+
+	ctx := testContext2(t, &ContextOpts{
+		Providers: map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		},
+	})
+
+	_, diags := ctx.Plan(m, state, &PlanOpts{
+		Mode: plans.NormalMode,
+	})
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want errors")
+	}
+	if got, want := diags.Err().Error(), "does not conform to the target resource type's schema"; !strings.Contains(got, want) {
+		t.Fatalf("wrong error:\ngot:  %s\nwant: message containing %q", got, want)
+	}
+}
+
 func TestContext2Plan_crossProviderMove(t *testing.T) {
 	addrA := mustResourceInstanceAddr("one_object.a")
 	addrB := mustResourceInstanceAddr("two_object.a")
@@ -7993,3 +8067,131 @@ func TestContext2Plan_deprecated_child_output_attr_in_root(t *testing.T) {
 		}))
 	}
 }
+
+func TestContext2Plan_providerDefaultTags(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+provider "test" {
+	default_tags = {
+		environment = "production"
+		team        = "core"
+	}
+}
+
+resource "test_object" "with_tags" {
+	tags = {
+		team = "networking"
+	}
+}
+
+resource "test_object" "without_tags" {
+}
+`,
+	})
+
+	schema := &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"tags": {
+				Type:     cty.Map(cty.String),
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+
+	p := new(testing_provider.MockProvider)
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"test_object": {Body: schema},
+		},
+	}
+	p.PlanResourceChangeFn = func(req providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+		return providers.PlanResourceChangeResponse{
+			PlannedState: req.ProposedNewState,
+		}
+	}
+
+	ctx := testContext2(t, &ContextOpts{
+		Providers: map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		},
+	})
+
+	plan, diags := ctx.Plan(m, states.NewState(), DefaultPlanOpts)
+	tfdiags.AssertNoErrors(t, diags)
+
+	withTagsAddr := mustResourceInstanceAddr("test_object.with_tags")
+	withTagsChange := plan.Changes.ResourceInstance(withTagsAddr)
+	if withTagsChange == nil {
+		t.Fatalf("no plan for %s", withTagsAddr)
+	}
+	withTagsAfter, err := withTagsChange.Decode(providers.Schema{Body: schema})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantWithTags := cty.MapVal(map[string]cty.Value{
+		"environment": cty.StringVal("production"),
+		"team":        cty.StringVal("networking"), // resource config wins over the provider default
+	})
+	if got := withTagsAfter.After.GetAttr("tags"); !got.RawEquals(wantWithTags) {
+		t.Errorf("wrong tags for %s\ngot:  %#v\nwant: %#v", withTagsAddr, got, wantWithTags)
+	}
+
+	withoutTagsAddr := mustResourceInstanceAddr("test_object.without_tags")
+	withoutTagsChange := plan.Changes.ResourceInstance(withoutTagsAddr)
+	if withoutTagsChange == nil {
+		t.Fatalf("no plan for %s", withoutTagsAddr)
+	}
+	withoutTagsAfter, err := withoutTagsChange.Decode(providers.Schema{Body: schema})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantWithoutTags := cty.MapVal(map[string]cty.Value{
+		"environment": cty.StringVal("production"),
+		"team":        cty.StringVal("core"),
+	})
+	if got := withoutTagsAfter.After.GetAttr("tags"); !got.RawEquals(wantWithoutTags) {
+		t.Errorf("wrong tags for %s\ngot:  %#v\nwant: %#v", withoutTagsAddr, got, wantWithoutTags)
+	}
+}
+
+func TestContext2Plan_deferredResourcePostDiffHook(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_object" "a" {
+	test_string = "foo"
+}
+`,
+	})
+
+	p := simpleMockProvider()
+	p.PlanResourceChangeFn = func(req providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+		return providers.PlanResourceChangeResponse{
+			PlannedState: req.ProposedNewState,
+			Deferred: &providers.Deferred{
+				Reason: providers.DeferredReasonProviderConfigUnknown,
+			},
+		}
+	}
+
+	hook := new(MockHook)
+	ctx := testContext2(t, &ContextOpts{
+		Hooks: []Hook{hook},
+		Providers: map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		},
+	})
+
+	_, diags := ctx.Plan(m, states.NewState(), &PlanOpts{
+		Mode:            plans.NormalMode,
+		DeferralAllowed: true,
+	})
+	tfdiags.AssertNoErrors(t, diags)
+
+	if !hook.PostDiffCalled {
+		t.Fatal("PostDiff hook was not called")
+	}
+	if !hook.PostDiffDeferred {
+		t.Error("expected PostDiff to report the change as deferred, but it did not")
+	}
+}
@@ -3685,7 +3685,10 @@ resource "test_object" "a" {
 }
 `,
 				},
-				expectPlanActionCalled: true,
+				// An unknown condition can't yet be resolved to true or
+				// false, so the action is deferred rather than planned
+				// against a guess.
+				expectPlanActionCalled: false,
 				planOpts: &PlanOpts{
 					Mode: plans.NormalMode,
 					SetVariables: InputValues{
@@ -3695,6 +3698,69 @@ resource "test_object" "a" {
 						},
 					},
 				},
+				assertPlan: func(t *testing.T, p *plans.Plan) {
+					if len(p.Changes.ActionInvocations) != 0 {
+						t.Fatalf("expected no actions in plan, got %d", len(p.Changes.ActionInvocations))
+					}
+					if len(p.DeferredActionInvocations) != 1 {
+						t.Fatalf("expected 1 deferred action invocation, got %d", len(p.DeferredActionInvocations))
+					}
+					deferred := p.DeferredActionInvocations[0]
+					if deferred.DeferredReason != providers.DeferredReasonDeferredPrereq {
+						t.Fatalf("expected deferred action to be deferred due to deferred prereq, but got %s", deferred.DeferredReason)
+					}
+					if deferred.ActionInvocationInstanceSrc.Addr.String() != "action.test_action.hello" {
+						t.Fatalf("expected deferred action to be action.test_action.hello, but got %s", deferred.ActionInvocationInstanceSrc.Addr.String())
+					}
+				},
+			},
+
+			"condition referencing an unknown module output value": {
+				module: map[string]string{
+					"main.tf": `
+module "child" {
+  source = "./child"
+}
+action "test_action" "hello" {}
+resource "test_object" "a" {
+  lifecycle {
+    action_trigger {
+      events = [before_create]
+      condition = module.child.out == "ready"
+      actions = [action.test_action.hello]
+    }
+  }
+}
+`,
+					"child/child.tf": `
+resource "test_object" "b" {}
+output "out" {
+  value = test_object.b.name
+}
+`,
+				},
+				planResourceFn: func(t *testing.T, req providers.PlanResourceChangeRequest) (resp providers.PlanResourceChangeResponse) {
+					resp.PlannedState = cty.ObjectVal(map[string]cty.Value{
+						"name": cty.UnknownVal(cty.String),
+					})
+					return resp
+				},
+				expectPlanActionCalled: false,
+				assertPlan: func(t *testing.T, p *plans.Plan) {
+					if len(p.Changes.ActionInvocations) != 0 {
+						t.Fatalf("expected no actions in plan, got %d", len(p.Changes.ActionInvocations))
+					}
+					if len(p.DeferredActionInvocations) != 1 {
+						t.Fatalf("expected 1 deferred action invocation, got %d", len(p.DeferredActionInvocations))
+					}
+					deferred := p.DeferredActionInvocations[0]
+					if deferred.DeferredReason != providers.DeferredReasonDeferredPrereq {
+						t.Fatalf("expected deferred action to be deferred due to deferred prereq, but got %s", deferred.DeferredReason)
+					}
+					if deferred.ActionInvocationInstanceSrc.Addr.String() != "action.test_action.hello" {
+						t.Fatalf("expected deferred action to be action.test_action.hello, but got %s", deferred.ActionInvocationInstanceSrc.Addr.String())
+					}
+				},
 			},
 
 			"before_create references caller": {
@@ -333,6 +333,20 @@ func TestContext_preloadedProviderSchemas(t *testing.T) {
 	}
 }
 
+func TestNewContext_reuseIdempotentApplyResults(t *testing.T) {
+	withoutCache, diags := NewContext(&ContextOpts{})
+	tfdiags.AssertNoDiagnostics(t, diags)
+	if withoutCache.applyResultCache != nil {
+		t.Error("applyResultCache should be nil unless ReuseIdempotentApplyResults is set")
+	}
+
+	withCache, diags := NewContext(&ContextOpts{ReuseIdempotentApplyResults: true})
+	tfdiags.AssertNoDiagnostics(t, diags)
+	if withCache.applyResultCache == nil {
+		t.Error("applyResultCache should be set when ReuseIdempotentApplyResults is set")
+	}
+}
+
 func testContext2(t testing.TB, opts *ContextOpts) *Context {
 	t.Helper()
 
@@ -7,6 +7,8 @@ import (
 	"log"
 	"time"
 
+	"github.com/zclconf/go-cty/cty"
+
 	"github.com/hashicorp/terraform/internal/addrs"
 	"github.com/hashicorp/terraform/internal/checks"
 	"github.com/hashicorp/terraform/internal/collections"
@@ -215,6 +217,7 @@ func (c *Context) graphWalker(graph *Graph, operation walkOperation, opts *graph
 		Deprecations:            deprecation.NewDeprecations(),
 		contexts:                collections.NewMap[evalContextScope, *BuiltinEvalContext](),
 		providerCache:           make(map[string]providers.Interface),
+		providerConfigCache:     make(map[string]cty.Value),
 		providerFuncCache:       make(map[string]providers.Interface),
 		providerSchemas:         make(map[string]providers.ProviderSchema),
 		provisionerCache:        make(map[string]provisioners.Interface),
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/plans"
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// pendingStateDeferral records that a resource instance's change was
+// deferred (for example, by the unknownProvider stub while a provider
+// configuration was still unknown) and so was never applied, meaning the
+// state has nothing new to say about that instance even though the
+// configuration expected a change.
+type pendingStateDeferral struct {
+	Addr   addrs.AbsResourceInstance
+	Reason providers.DeferredReason
+}
+
+// pendingStateDeferralsFromPlan extracts the set of pending deferrals that an
+// apply of the given plan would leave behind, for recording alongside the
+// state once the apply completes.
+func pendingStateDeferralsFromPlan(deferred []*plans.DeferredResourceInstanceChangeSrc) []pendingStateDeferral {
+	if len(deferred) == 0 {
+		return nil
+	}
+
+	ret := make([]pendingStateDeferral, 0, len(deferred))
+	for _, d := range deferred {
+		ret = append(ret, pendingStateDeferral{
+			Addr:   d.ChangeSrc.Addr,
+			Reason: d.DeferredReason,
+		})
+	}
+	return ret
+}
+
+// warnPendingStateDeferrals builds a warning diagnostic listing any
+// deferrals that were recorded against the state by a previous apply and
+// are still present, so that an operator planning again can see that those
+// resource instances were never actually brought up to date.
+//
+// It returns no diagnostics if there are no pending deferrals to report.
+func warnPendingStateDeferrals(pending []pendingStateDeferral) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	if len(pending) == 0 {
+		return diags
+	}
+
+	addrStrs := make([]string, len(pending))
+	for i, p := range pending {
+		addrStrs[i] = p.Addr.String()
+	}
+	sort.Strings(addrStrs)
+
+	diags = diags.Append(tfdiags.Sourceless(
+		tfdiags.Warning,
+		"Resource instances remain deferred from a previous apply",
+		fmt.Sprintf(
+			"The following resource instances were deferred during a previous apply and were never brought up to date:\n  - %s\n\nTerraform will keep retrying them on subsequent plans until their deferral can be resolved.",
+			strings.Join(addrStrs, "\n  - "),
+		),
+	))
+	return diags
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/plans"
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+func TestPendingStateDeferralsFromPlan(t *testing.T) {
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	t.Run("no deferred changes", func(t *testing.T) {
+		got := pendingStateDeferralsFromPlan(nil)
+		if len(got) != 0 {
+			t.Fatalf("expected no pending deferrals, got %#v", got)
+		}
+	})
+
+	t.Run("records address and reason for each deferred change", func(t *testing.T) {
+		deferred := []*plans.DeferredResourceInstanceChangeSrc{
+			{
+				DeferredReason: providers.DeferredReasonProviderConfigUnknown,
+				ChangeSrc:      &plans.ResourceInstanceChangeSrc{Addr: addr},
+			},
+		}
+
+		got := pendingStateDeferralsFromPlan(deferred)
+		if len(got) != 1 {
+			t.Fatalf("expected exactly one pending deferral, got %#v", got)
+		}
+		if !got[0].Addr.Equal(addr) {
+			t.Fatalf("wrong address: got %s, want %s", got[0].Addr, addr)
+		}
+		if got[0].Reason != providers.DeferredReasonProviderConfigUnknown {
+			t.Fatalf("wrong reason: got %s", got[0].Reason)
+		}
+	})
+}
+
+func TestWarnPendingStateDeferrals(t *testing.T) {
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	t.Run("no pending deferrals produces no diagnostics", func(t *testing.T) {
+		diags := warnPendingStateDeferrals(nil)
+		if diags.HasErrors() || len(diags) != 0 {
+			t.Fatalf("expected no diagnostics, got %s", diags.Err())
+		}
+	})
+
+	t.Run("pending deferrals produce a warning naming the resource", func(t *testing.T) {
+		diags := warnPendingStateDeferrals([]pendingStateDeferral{
+			{Addr: addr, Reason: providers.DeferredReasonProviderConfigUnknown},
+		})
+		if len(diags) != 1 {
+			t.Fatalf("expected exactly one diagnostic, got %d", len(diags))
+		}
+		if diags[0].Severity() != tfdiags.Warning {
+			t.Fatalf("expected a warning, got %s", diags[0].Severity())
+		}
+		if !strings.Contains(diags[0].Description().Detail, addr.String()) {
+			t.Fatalf("expected the warning to mention %s, got: %s", addr, diags[0].Description().Detail)
+		}
+	})
+}
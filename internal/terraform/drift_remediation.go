@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import "github.com/zclconf/go-cty/cty"
+
+// driftRemediationDecision describes how a drift-remediation-aware refresh
+// should treat a single resource instance once its drift (if any) has been
+// determined.
+type driftRemediationDecision int
+
+const (
+	// driftRemediationSkip means the instance should be left as-is: either
+	// there was no drift, or we don't yet have a real refreshed value to
+	// reconcile against.
+	driftRemediationSkip driftRemediationDecision = iota
+
+	// driftRemediationUpdate means the instance has drifted from a real,
+	// non-deferred refreshed value and should be corrected back to match
+	// configuration during apply.
+	driftRemediationUpdate
+)
+
+// decideDriftRemediation inspects the result of refreshing a single resource
+// instance and decides whether a drift-auto-remediation-aware plan should
+// treat it as something to correct during apply.
+//
+// deferred reports whether the ReadResource call that produced newVal came
+// back as deferred (for example, because its provider configuration is
+// still unknown, as with the unknownProvider stub). Deferred reads carry no
+// real information about the object's current state, so they are always
+// skipped rather than treated as drift.
+func decideDriftRemediation(deferred bool, oldVal, newVal cty.Value) driftRemediationDecision {
+	if deferred {
+		return driftRemediationSkip
+	}
+	if oldVal.RawEquals(newVal) {
+		return driftRemediationSkip
+	}
+	return driftRemediationUpdate
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestDecideDriftRemediation(t *testing.T) {
+	tests := map[string]struct {
+		deferred bool
+		old, new cty.Value
+		want     driftRemediationDecision
+	}{
+		"no drift": {
+			old:  cty.StringVal("a"),
+			new:  cty.StringVal("a"),
+			want: driftRemediationSkip,
+		},
+		"drifted": {
+			old:  cty.StringVal("a"),
+			new:  cty.StringVal("b"),
+			want: driftRemediationUpdate,
+		},
+		"deferred read is skipped even if it looks drifted": {
+			deferred: true,
+			old:      cty.StringVal("a"),
+			new:      cty.StringVal("b"),
+			want:     driftRemediationSkip,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := decideDriftRemediation(test.deferred, test.old, test.new)
+			if got != test.want {
+				t.Fatalf("wrong result\ngot:  %d\nwant: %d", got, test.want)
+			}
+		})
+	}
+}
@@ -96,6 +96,16 @@ type EvalContext interface {
 	ProviderInput(addrs.AbsProviderConfig) map[string]cty.Value
 	SetProviderInput(addrs.AbsProviderConfig, map[string]cty.Value)
 
+	// ProviderDefaultTags and SetProviderDefaultTags store and retrieve the
+	// evaluated value of a provider configuration's "default_tags"
+	// argument, so that resource nodes using that provider can merge it
+	// into their own configuration before planning.
+	//
+	// These methods will panic if the module instance address of the given
+	// provider configuration does not match the Path() of the EvalContext.
+	ProviderDefaultTags(addrs.AbsProviderConfig) cty.Value
+	SetProviderDefaultTags(addrs.AbsProviderConfig, cty.Value)
+
 	// Provisioner gets the provisioner instance with the given name.
 	Provisioner(string) (provisioners.Interface, error)
 
@@ -240,6 +250,12 @@ type EvalContext interface {
 	// Deprecations returns the deprecations object that tracks meta-information
 	// about deprecation, e.g. which module calls suppress deprecation warnings.
 	Deprecations() *deprecation.Deprecations
+
+	// applyResultCache returns the operation-wide cache of provider apply
+	// results, or nil if ContextOpts didn't request one. It's nil for an
+	// ordinary apply operation; see applyResultCache for why a caller might
+	// opt in.
+	applyResultCache() *applyResultCache
 }
 
 func evalContextForModuleInstance(baseCtx EvalContext, addr addrs.ModuleInstance) EvalContext {
@@ -76,28 +76,36 @@ type BuiltinEvalContext struct {
 	// only allowd in the context of a destroy plan.
 	forget bool
 
-	Hooks                   []Hook
-	InputValue              UIInput
-	ProviderCache           map[string]providers.Interface
-	ProviderFuncCache       map[string]providers.Interface
-	FunctionResults         *lang.FunctionResults
-	ProviderInputConfig     map[string]map[string]cty.Value
-	ProviderLock            *sync.Mutex
-	ProvisionerCache        map[string]provisioners.Interface
-	ProvisionerLock         *sync.Mutex
-	ChangesValue            *plans.ChangesSync
-	StateValue              *states.SyncState
-	ChecksValue             *checks.State
-	EphemeralResourcesValue *ephemeral.Resources
-	RefreshStateValue       *states.SyncState
-	PrevRunStateValue       *states.SyncState
-	PolicyGraphValue        *policySubgraph
-	InstanceExpanderValue   *instances.Expander
-	MoveResultsValue        refactoring.MoveResults
-	OverrideValues          *mocking.Overrides
-	ProviderLocksValue      map[addrs.Provider]*depsfile.ProviderLock
-	PolicyClientValue       policy.Client
-	DeprecationsValue       *deprecation.Deprecations
+	Hooks         []Hook
+	InputValue    UIInput
+	ProviderCache map[string]providers.Interface
+	// ProviderConfigCache records the most recent configuration passed to
+	// ConfigureProvider for each provider instance, so that it can tell
+	// whether a subsequent reconfiguration actually changes anything; see
+	// providerConfigChanged. Guarded by ProviderLock, like ProviderCache.
+	ProviderConfigCache      map[string]cty.Value
+	ProviderFuncCache        map[string]providers.Interface
+	FunctionResults          *lang.FunctionResults
+	ProviderInputConfig      map[string]map[string]cty.Value
+	ProviderDefaultTagsVal   map[string]cty.Value
+	ProviderLock             *sync.Mutex
+	ProvisionerCache         map[string]provisioners.Interface
+	ProvisionerLock          *sync.Mutex
+	ChangesValue             *plans.ChangesSync
+	StateValue               *states.SyncState
+	ChecksValue              *checks.State
+	EphemeralResourcesValue  *ephemeral.Resources
+	RefreshStateValue        *states.SyncState
+	PrevRunStateValue        *states.SyncState
+	PolicyGraphValue         *policySubgraph
+	InstanceExpanderValue    *instances.Expander
+	MoveResultsValue         refactoring.MoveResults
+	OverrideValues           *mocking.Overrides
+	ProviderLocksValue       map[addrs.Provider]*depsfile.ProviderLock
+	PolicyClientValue        policy.Client
+	DeprecationsValue        *deprecation.Deprecations
+	ApplyResultCacheValue    *applyResultCache
+	ProviderRateLimiterValue *ProviderApplyRateLimiter
 }
 
 func (ctx *BuiltinEvalContext) ProviderLocks() map[addrs.Provider]*depsfile.ProviderLock {
@@ -253,6 +261,22 @@ func (ctx *BuiltinEvalContext) ConfigureProvider(addr addrs.AbsProviderConfig, c
 		ClientCapabilities: ctx.ClientCapabilities(),
 	}
 
+	key := addr.String()
+	ctx.ProviderLock.Lock()
+	if oldCfg, exists := ctx.ProviderConfigCache[key]; exists && providerConfigChanged(oldCfg, cfg) {
+		log.Printf("[DEBUG] %s: configuration changed since it was last configured, reconfiguring", addr)
+		state := ctx.State()
+		if state != nil {
+			impact := AnalyzeProviderConfigChangeImpact(state.Lock(), addr, !cfg.IsWhollyKnown())
+			state.Unlock()
+			log.Printf("[DEBUG] %s: reconfiguration affects %d resource(s) to re-plan, %d to re-read, %d deferred", addr, len(impact.RePlanned), len(impact.ReRead), len(impact.Deferred))
+		}
+	}
+	if ctx.ProviderConfigCache != nil {
+		ctx.ProviderConfigCache[key] = cfg
+	}
+	ctx.ProviderLock.Unlock()
+
 	resp := p.ConfigureProvider(req)
 	return resp.Diagnostics
 }
@@ -289,6 +313,37 @@ func (ctx *BuiltinEvalContext) SetProviderInput(pc addrs.AbsProviderConfig, c ma
 	ctx.ProviderLock.Unlock()
 }
 
+func (ctx *BuiltinEvalContext) ProviderDefaultTags(pc addrs.AbsProviderConfig) cty.Value {
+	ctx.ProviderLock.Lock()
+	defer ctx.ProviderLock.Unlock()
+
+	if !pc.Module.Equal(ctx.Path().Module()) {
+		// This indicates incorrect use of ProviderDefaultTags: it should be
+		// used only from the module that the provider configuration
+		// belongs to.
+		panic(fmt.Sprintf("%s queried for default tags by wrong module %s", pc, ctx.Path()))
+	}
+
+	val, ok := ctx.ProviderDefaultTagsVal[pc.String()]
+	if !ok {
+		return cty.NilVal
+	}
+	return val
+}
+
+func (ctx *BuiltinEvalContext) SetProviderDefaultTags(pc addrs.AbsProviderConfig, val cty.Value) {
+	if !pc.Module.Equal(ctx.Path().Module()) {
+		// This indicates incorrect use of SetProviderDefaultTags: it should
+		// be used only from the module that the provider configuration
+		// belongs to.
+		panic(fmt.Sprintf("%s configured with default tags by wrong module %s", pc, ctx.Path()))
+	}
+
+	ctx.ProviderLock.Lock()
+	ctx.ProviderDefaultTagsVal[pc.String()] = val
+	ctx.ProviderLock.Unlock()
+}
+
 func (ctx *BuiltinEvalContext) Provisioner(n string) (provisioners.Interface, error) {
 	ctx.ProvisionerLock.Lock()
 	defer ctx.ProvisionerLock.Unlock()
@@ -684,3 +739,11 @@ func (ctx *BuiltinEvalContext) ClientCapabilities() providers.ClientCapabilities
 func (ctx *BuiltinEvalContext) Deprecations() *deprecation.Deprecations {
 	return ctx.DeprecationsValue
 }
+
+func (ctx *BuiltinEvalContext) applyResultCache() *applyResultCache {
+	return ctx.ApplyResultCacheValue
+}
+
+func (ctx *BuiltinEvalContext) providerApplyRateLimiter() *ProviderApplyRateLimiter {
+	return ctx.ProviderRateLimiterValue
+}
@@ -78,6 +78,14 @@ type MockEvalContext struct {
 	SetProviderInputAddr   addrs.AbsProviderConfig
 	SetProviderInputValues map[string]cty.Value
 
+	ProviderDefaultTagsCalled bool
+	ProviderDefaultTagsAddr   addrs.AbsProviderConfig
+	ProviderDefaultTagsValue  cty.Value
+
+	SetProviderDefaultTagsCalled bool
+	SetProviderDefaultTagsAddr   addrs.AbsProviderConfig
+	SetProviderDefaultTagsValue  cty.Value
+
 	ConfigureProviderFn func(
 		addr addrs.AbsProviderConfig,
 		cfg cty.Value) tfdiags.Diagnostics // overrides the other values below, if set
@@ -173,11 +181,12 @@ type MockEvalContext struct {
 	ForgetCalled bool
 	ForgetValues bool
 
-	ProviderLocksValue map[addrs.Provider]*depsfile.ProviderLock
-	PolicyClientValue  policy.Client
-	ConfigValue        *configs.Config
-	DeprecationCalled  bool
-	DeprecationState   *deprecation.Deprecations
+	ProviderLocksValue    map[addrs.Provider]*depsfile.ProviderLock
+	PolicyClientValue     policy.Client
+	ConfigValue           *configs.Config
+	DeprecationCalled     bool
+	DeprecationState      *deprecation.Deprecations
+	ApplyResultCacheValue *applyResultCache
 }
 
 // MockEvalContext implements EvalContext
@@ -261,6 +270,18 @@ func (c *MockEvalContext) SetProviderInput(addr addrs.AbsProviderConfig, vals ma
 	c.SetProviderInputValues = vals
 }
 
+func (c *MockEvalContext) ProviderDefaultTags(addr addrs.AbsProviderConfig) cty.Value {
+	c.ProviderDefaultTagsCalled = true
+	c.ProviderDefaultTagsAddr = addr
+	return c.ProviderDefaultTagsValue
+}
+
+func (c *MockEvalContext) SetProviderDefaultTags(addr addrs.AbsProviderConfig, val cty.Value) {
+	c.SetProviderDefaultTagsCalled = true
+	c.SetProviderDefaultTagsAddr = addr
+	c.SetProviderDefaultTagsValue = val
+}
+
 func (c *MockEvalContext) Provisioner(n string) (provisioners.Interface, error) {
 	c.ProvisionerCalled = true
 	c.ProvisionerName = n
@@ -481,3 +502,7 @@ func (c *MockEvalContext) Deprecations() *deprecation.Deprecations {
 	}
 	return deprecation.NewDeprecations()
 }
+
+func (c *MockEvalContext) applyResultCache() *applyResultCache {
+	return c.ApplyResultCacheValue
+}
@@ -5,6 +5,7 @@ package terraform
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform/internal/configs/configschema"
 	"github.com/hashicorp/terraform/internal/instances"
 	"github.com/hashicorp/terraform/internal/lang/marks"
+	"github.com/hashicorp/terraform/internal/providers"
 	"github.com/hashicorp/terraform/internal/tfdiags"
 )
 
@@ -76,6 +78,43 @@ func evaluateImportIdExpression(expr hcl.Expression, ctx EvalContext, keyData in
 	return importIdVal, diags
 }
 
+// validateImportIDFormat checks a known import ID string against the
+// ImportIDFormat regular expression declared by the provider's schema for
+// the resource type being imported, if any. An empty ImportIDFormat means
+// the provider has not declared a format, so any ID is accepted.
+func validateImportIDFormat(id string, schema providers.Schema, rng *hcl.Range) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	if schema.ImportIDFormat == "" {
+		return diags
+	}
+
+	re, err := regexp.Compile(schema.ImportIDFormat)
+	if err != nil {
+		// The provider declared an invalid regular expression. This is a
+		// provider bug, but we still want to surface it clearly rather than
+		// panicking or silently skipping validation.
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid import id argument",
+			Detail:   fmt.Sprintf("The provider declared an invalid import ID format %q: %s.", schema.ImportIDFormat, err),
+			Subject:  rng,
+		})
+		return diags
+	}
+
+	if !re.MatchString(id) {
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid import id argument",
+			Detail:   fmt.Sprintf("The given import ID %q does not match the format expected by the provider: %q.", id, schema.ImportIDFormat),
+			Subject:  rng,
+		})
+	}
+
+	return diags
+}
+
 // evaluateImportIdentityExpression evaluates the given expression to determine the
 // import identity for a resource. It uses the resource identity schema to validate
 // the structure of the object..
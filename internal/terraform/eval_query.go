@@ -46,3 +46,16 @@ func newIncludeRscEvaluator(allowUnknown bool) *ExprEvaluator[cty.Type, bool] {
 		allowEphemeral: true, // No reason to disallow ephemeral values here
 	}
 }
+
+// newSortByEvaluator returns an evaluator for the sort_by expression within a
+// list block, used to client-side sort results from providers that don't
+// support sorting themselves; see sortAndLimitListResults.
+func newSortByEvaluator(allowUnknown bool) *ExprEvaluator[cty.Type, string] {
+	return &ExprEvaluator[cty.Type, string]{
+		cType:          cty.String,
+		defaultValue:   "",
+		argName:        "sort_by",
+		allowUnknown:   allowUnknown,
+		allowEphemeral: true, // No reason to disallow ephemeral values here
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import "sync"
+
+// ForEachSemaphorePool grants a per-resource concurrency limit for resources
+// that use for_each, independent of the global operation-wide parallelism
+// semaphore. ContextGraphWalker.Execute acquires the global semaphore as it
+// always has, and additionally acquires the semaphore returned for a
+// resource's address here -- via ForResourceWithLimit, using the limit set
+// by that resource's "max_parallel" lifecycle argument -- before starting
+// work on one of its instances.
+//
+// Resources with no configured limit return a nil semaphore from
+// ForResource and ForResourceWithLimit, which callers should treat as "no
+// additional limit to enforce".
+type ForEachSemaphorePool struct {
+	mu    sync.Mutex
+	limit map[string]int
+	sems  map[string]Semaphore
+}
+
+// NewForEachSemaphorePool returns an empty pool with no per-resource limits
+// configured.
+func NewForEachSemaphorePool() *ForEachSemaphorePool {
+	return &ForEachSemaphorePool{
+		limit: make(map[string]int),
+		sems:  make(map[string]Semaphore),
+	}
+}
+
+// SetLimit configures the maximum number of concurrent instance operations
+// permitted for the given resource address's for_each expansion. A limit of
+// zero or less clears any previously configured limit for that resource.
+func (p *ForEachSemaphorePool) SetLimit(resourceAddr string, limit int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if limit <= 0 {
+		delete(p.limit, resourceAddr)
+		delete(p.sems, resourceAddr)
+		return
+	}
+	p.limit[resourceAddr] = limit
+	// Drop any existing semaphore so the next call to ForResource builds one
+	// at the new limit.
+	delete(p.sems, resourceAddr)
+}
+
+// ForResourceWithLimit returns the semaphore that limits concurrent
+// instance operations for resourceAddr to limit, creating it on the first
+// call for that address and reusing it on every later call regardless of
+// what limit is passed then.
+//
+// This is the entry point the graph walker actually uses: unlike SetLimit
+// followed by ForResource, it's safe to call concurrently from every
+// instance of the same resource, since only the first caller for a given
+// resourceAddr establishes the semaphore -- callers racing in from other
+// instances of the same resource reuse it rather than each resetting it,
+// which would otherwise undermine the very limit they're trying to enforce.
+// limit is the same for every instance of a given resource (it comes from
+// that resource's own configuration), so which caller happens to go first
+// doesn't matter. A limit of zero or less means no semaphore is needed.
+func (p *ForEachSemaphorePool) ForResourceWithLimit(resourceAddr string, limit int) Semaphore {
+	if limit <= 0 {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if sem, ok := p.sems[resourceAddr]; ok {
+		return sem
+	}
+	sem := NewSemaphore(limit)
+	p.sems[resourceAddr] = sem
+	p.limit[resourceAddr] = limit
+	return sem
+}
+
+// ForResource returns the semaphore that should be acquired before
+// processing an instance of the given resource's for_each expansion, or nil
+// if no resource-level limit has been configured for that resource.
+func (p *ForEachSemaphorePool) ForResource(resourceAddr string) Semaphore {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	limit, ok := p.limit[resourceAddr]
+	if !ok {
+		return nil
+	}
+	sem, ok := p.sems[resourceAddr]
+	if !ok {
+		sem = NewSemaphore(limit)
+		p.sems[resourceAddr] = sem
+	}
+	return sem
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestForEachSemaphorePool(t *testing.T) {
+	t.Run("no limit configured", func(t *testing.T) {
+		p := NewForEachSemaphorePool()
+		if sem := p.ForResource("test_thing.foo"); sem != nil {
+			t.Fatalf("expected no semaphore, got %#v", sem)
+		}
+	})
+
+	t.Run("limit is respected independently of other resources", func(t *testing.T) {
+		p := NewForEachSemaphorePool()
+		p.SetLimit("test_thing.foo", 2)
+
+		sem := p.ForResource("test_thing.foo")
+		if sem == nil {
+			t.Fatal("expected a semaphore")
+		}
+
+		var active int32
+		var maxActive int32
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sem.Acquire()
+				defer sem.Release()
+				n := atomic.AddInt32(&active, 1)
+				for {
+					old := atomic.LoadInt32(&maxActive)
+					if n <= old || atomic.CompareAndSwapInt32(&maxActive, old, n) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&active, -1)
+			}()
+		}
+		wg.Wait()
+
+		if maxActive > 2 {
+			t.Fatalf("expected at most 2 concurrent instances, saw %d", maxActive)
+		}
+
+		// A resource with no configured limit is unaffected.
+		if other := p.ForResource("test_thing.bar"); other != nil {
+			t.Fatalf("expected no semaphore for an unconfigured resource, got %#v", other)
+		}
+	})
+
+	t.Run("clearing the limit removes the semaphore", func(t *testing.T) {
+		p := NewForEachSemaphorePool()
+		p.SetLimit("test_thing.foo", 1)
+		if p.ForResource("test_thing.foo") == nil {
+			t.Fatal("expected a semaphore")
+		}
+
+		p.SetLimit("test_thing.foo", 0)
+		if sem := p.ForResource("test_thing.foo"); sem != nil {
+			t.Fatalf("expected no semaphore after clearing the limit, got %#v", sem)
+		}
+	})
+}
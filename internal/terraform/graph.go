@@ -138,6 +138,24 @@ func (g *Graph) walk(walker GraphWalker) tfdiags.Diagnostics {
 			log.Printf("[TRACE] vertex %q: does not belong to any module instance", dag.VertexName(v))
 		}
 
+		// If the operation requested an apply result cache and the node can
+		// consult one, attach it before executing. As with overrides above,
+		// we do this from this single location rather than asking every
+		// NodeAbstractResourceInstance constructor to thread it through.
+		if consumer, ok := v.(graphNodeApplyResultCacheConsumer); ok {
+			if cache := vertexCtx.applyResultCache(); cache != nil {
+				consumer.SetApplyResultCache(cache)
+			}
+		}
+
+		// Likewise, if the operation requested provider apply rate limits,
+		// attach the shared limiter before executing.
+		if consumer, ok := v.(graphNodeProviderApplyRateLimiterConsumer); ok {
+			if limiter := vertexCtx.providerApplyRateLimiter(); limiter != nil {
+				consumer.SetProviderApplyRateLimiter(limiter)
+			}
+		}
+
 		// If the node is exec-able, then execute it.
 		if ev, ok := v.(GraphNodeExecutable); ok {
 			diags = diags.Append(walker.Execute(vertexCtx, ev))
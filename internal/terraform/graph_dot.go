@@ -16,3 +16,13 @@ func GraphDot(g *Graph, opts *dag.DotOpts) (string, error) {
 func GraphMermaid(g *Graph, opts *dag.DotOpts) (string, error) {
 	return string(g.Mermaid(opts)), nil
 }
+
+// GraphJSON returns a JSON representation of the given Terraform graph,
+// describing its vertices and edges.
+func GraphJSON(g *Graph) (string, error) {
+	raw, err := g.JSON()
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
@@ -8,6 +8,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/zclconf/go-cty/cty"
+
 	"github.com/hashicorp/terraform/internal/addrs"
 	"github.com/hashicorp/terraform/internal/checks"
 	"github.com/hashicorp/terraform/internal/collections"
@@ -69,16 +71,17 @@ type ContextGraphWalker struct {
 	PolicyClient policy.Client
 	PolicyGraph  *policySubgraph // Used for writing resource policy evaluation nodes
 
-	contexts           collections.Map[evalContextScope, *BuiltinEvalContext]
-	contextLock        sync.Mutex
-	providerCache      map[string]providers.Interface
-	providerFuncCache  map[string]providers.Interface
-	functionResults    *lang.FunctionResults
-	providerSchemas    map[string]providers.ProviderSchema
-	providerLock       sync.Mutex
-	provisionerCache   map[string]provisioners.Interface
-	provisionerSchemas map[string]*configschema.Block
-	provisionerLock    sync.Mutex
+	contexts            collections.Map[evalContextScope, *BuiltinEvalContext]
+	contextLock         sync.Mutex
+	providerCache       map[string]providers.Interface
+	providerConfigCache map[string]cty.Value
+	providerFuncCache   map[string]providers.Interface
+	functionResults     *lang.FunctionResults
+	providerSchemas     map[string]providers.ProviderSchema
+	providerLock        sync.Mutex
+	provisionerCache    map[string]provisioners.Interface
+	provisionerSchemas  map[string]*configschema.Block
+	provisionerLock     sync.Mutex
 }
 
 var _ GraphWalker = (*ContextGraphWalker)(nil)
@@ -131,44 +134,81 @@ func (w *ContextGraphWalker) EvalContext() EvalContext {
 	}
 
 	ctx := &BuiltinEvalContext{
-		StopContext:             w.StopContext,
-		Hooks:                   w.Context.hooks,
-		InputValue:              w.Context.uiInput,
-		EphemeralResourcesValue: w.EphemeralResources,
-		InstanceExpanderValue:   w.InstanceExpander,
-		Plugins:                 w.Context.plugins,
-		ExternalProviderConfigs: w.ExternalProviderConfigs,
-		MoveResultsValue:        w.MoveResults,
-		ProviderCache:           w.providerCache,
-		ProviderFuncCache:       w.providerFuncCache,
-		FunctionResults:         w.functionResults,
-		ProviderInputConfig:     w.Context.providerInputConfig,
-		ProviderLock:            &w.providerLock,
-		ProvisionerCache:        w.provisionerCache,
-		ProvisionerLock:         &w.provisionerLock,
-		ChangesValue:            w.Changes,
-		ChecksValue:             w.Checks,
-		NamedValuesValue:        w.NamedValues,
-		DeferralsValue:          w.Deferrals,
-		StateValue:              w.State,
-		RefreshStateValue:       w.RefreshState,
-		PrevRunStateValue:       w.PrevRunState,
-		PolicyGraphValue:        w.PolicyGraph,
-		Evaluator:               evaluator,
-		OverrideValues:          w.Overrides,
-		forget:                  w.Forget,
-		ProviderLocksValue:      w.ProviderLocks,
-		PolicyClientValue:       w.PolicyClient,
-		DeprecationsValue:       w.Deprecations,
+		StopContext:              w.StopContext,
+		Hooks:                    w.Context.hooks,
+		InputValue:               w.Context.uiInput,
+		EphemeralResourcesValue:  w.EphemeralResources,
+		InstanceExpanderValue:    w.InstanceExpander,
+		Plugins:                  w.Context.plugins,
+		ExternalProviderConfigs:  w.ExternalProviderConfigs,
+		MoveResultsValue:         w.MoveResults,
+		ProviderCache:            w.providerCache,
+		ProviderConfigCache:      w.providerConfigCache,
+		ProviderFuncCache:        w.providerFuncCache,
+		FunctionResults:          w.functionResults,
+		ProviderInputConfig:      w.Context.providerInputConfig,
+		ProviderDefaultTagsVal:   w.Context.providerDefaultTags,
+		ProviderLock:             &w.providerLock,
+		ProvisionerCache:         w.provisionerCache,
+		ProvisionerLock:          &w.provisionerLock,
+		ChangesValue:             w.Changes,
+		ChecksValue:              w.Checks,
+		NamedValuesValue:         w.NamedValues,
+		DeferralsValue:           w.Deferrals,
+		StateValue:               w.State,
+		RefreshStateValue:        w.RefreshState,
+		PrevRunStateValue:        w.PrevRunState,
+		PolicyGraphValue:         w.PolicyGraph,
+		Evaluator:                evaluator,
+		OverrideValues:           w.Overrides,
+		forget:                   w.Forget,
+		ProviderLocksValue:       w.ProviderLocks,
+		PolicyClientValue:        w.PolicyClient,
+		DeprecationsValue:        w.Deprecations,
+		ApplyResultCacheValue:    w.Context.applyResultCache,
+		ProviderRateLimiterValue: w.Context.providerRateLimiter,
 	}
 
 	return ctx
 }
 
+// graphNodeApplySerial is implemented by graph nodes whose lifecycle
+// requests that they never be applied concurrently with any other node
+// that also implements this interface, even when nothing about the
+// dependency graph otherwise requires that.
+type graphNodeApplySerial interface {
+	ApplySerial() bool
+}
+
+// graphNodeMaxParallel is implemented by graph nodes whose lifecycle limits
+// how many instances of their resource's for_each (or count) expansion may
+// be processed concurrently.
+type graphNodeMaxParallel interface {
+	GraphNodeResourceInstance
+	MaxParallel() int
+}
+
 func (w *ContextGraphWalker) Execute(ctx EvalContext, n GraphNodeExecutable) tfdiags.Diagnostics {
 	// Acquire a lock on the semaphore
 	w.Context.parallelSem.Acquire()
 	defer w.Context.parallelSem.Release()
 
+	if w.Operation == walkApply || w.Operation == walkDestroy {
+		if serial, ok := n.(graphNodeApplySerial); ok && serial.ApplySerial() {
+			w.Context.applySerialSem.Acquire()
+			defer w.Context.applySerialSem.Release()
+		}
+
+		if limited, ok := n.(graphNodeMaxParallel); ok {
+			if limit := limited.MaxParallel(); limit > 0 {
+				resourceAddr := limited.ResourceInstanceAddr().ContainingResource().String()
+				if sem := w.Context.forEachSemaphores.ForResourceWithLimit(resourceAddr, limit); sem != nil {
+					sem.Acquire()
+					defer sem.Release()
+				}
+			}
+		}
+	}
+
 	return n.Execute(ctx, w.Operation)
 }
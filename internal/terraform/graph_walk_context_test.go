@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// testApplySerialNode is a minimal GraphNodeExecutable used to observe
+// whether ContextGraphWalker.Execute ever runs apply_serial-flagged nodes
+// concurrently with one another.
+type testApplySerialNode struct {
+	serial  bool
+	running *int32
+	started chan<- struct{}
+	proceed <-chan struct{}
+}
+
+func (n *testApplySerialNode) Name() string {
+	return "test node"
+}
+
+func (n *testApplySerialNode) ApplySerial() bool {
+	return n.serial
+}
+
+func (n *testApplySerialNode) Execute(EvalContext, walkOperation) tfdiags.Diagnostics {
+	if atomic.AddInt32(n.running, 1) > 1 {
+		panic("apply_serial nodes ran concurrently")
+	}
+	n.started <- struct{}{}
+	<-n.proceed
+	atomic.AddInt32(n.running, -1)
+	return nil
+}
+
+func TestContextGraphWalker_applySerial(t *testing.T) {
+	walker := &ContextGraphWalker{
+		Context: &Context{
+			parallelSem:    NewSemaphore(10),
+			applySerialSem: NewSemaphore(1),
+		},
+		Operation: walkApply,
+	}
+
+	var running int32
+	started := make(chan struct{})
+	proceed := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			walker.Execute(nil, &testApplySerialNode{
+				serial:  true,
+				running: &running,
+				started: started,
+				proceed: proceed,
+			})
+		}()
+	}
+
+	// The first node should start, but the second must not be able to start
+	// until the first has finished, since both are flagged apply_serial.
+	<-started
+	select {
+	case <-started:
+		t.Fatal("both apply_serial nodes started concurrently")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	proceed <- struct{}{}
+	<-started
+	proceed <- struct{}{}
+
+	wg.Wait()
+}
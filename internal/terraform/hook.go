@@ -74,8 +74,15 @@ type Hook interface {
 	// PreDiff and PostDiff are called before and after a provider is given
 	// the opportunity to customize the proposed new state to produce the
 	// planned new state.
+	//
+	// PostDiff's deferred argument is true when the provider was unable to
+	// fully plan this change and it has been deferred to a future run, as
+	// can happen when an upstream dependency (such as a provider
+	// configuration) is not yet known. Callers that attach a cost to each
+	// planned change, for example, should treat a deferred change as having
+	// an unknown rather than zero cost rather than disregarding it.
 	PreDiff(id HookResourceIdentity, dk addrs.DeposedKey, priorState, proposedNewState cty.Value, err error) (HookAction, error)
-	PostDiff(id HookResourceIdentity, dk addrs.DeposedKey, action plans.Action, priorState, plannedNewState cty.Value, err error) (HookAction, error)
+	PostDiff(id HookResourceIdentity, dk addrs.DeposedKey, action plans.Action, priorState, plannedNewState cty.Value, deferred bool, err error) (HookAction, error)
 
 	// The provisioning hooks signal both the overall start end end of
 	// provisioning for a particular instance and of each of the individual
@@ -183,7 +190,7 @@ func (*NilHook) PreDiff(id HookResourceIdentity, dk addrs.DeposedKey, priorState
 	return HookActionContinue, nil
 }
 
-func (*NilHook) PostDiff(id HookResourceIdentity, dk addrs.DeposedKey, action plans.Action, priorState, plannedNewState cty.Value, err error) (HookAction, error) {
+func (*NilHook) PostDiff(id HookResourceIdentity, dk addrs.DeposedKey, action plans.Action, priorState, plannedNewState cty.Value, deferred bool, err error) (HookAction, error) {
 	return HookActionContinue, nil
 }
 
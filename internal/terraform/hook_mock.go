@@ -53,6 +53,7 @@ type MockHook struct {
 	PostDiffAction       plans.Action
 	PostDiffPriorState   cty.Value
 	PostDiffPlannedState cty.Value
+	PostDiffDeferred     bool
 	PostDiffReturn       HookAction
 	PostDiffError        error
 
@@ -224,7 +225,7 @@ func (h *MockHook) PreDiff(id HookResourceIdentity, dk addrs.DeposedKey, priorSt
 	return h.PreDiffReturn, h.PreDiffError
 }
 
-func (h *MockHook) PostDiff(id HookResourceIdentity, dk addrs.DeposedKey, action plans.Action, priorState, plannedNewState cty.Value, err error) (HookAction, error) {
+func (h *MockHook) PostDiff(id HookResourceIdentity, dk addrs.DeposedKey, action plans.Action, priorState, plannedNewState cty.Value, deferred bool, err error) (HookAction, error) {
 	h.Lock()
 	defer h.Unlock()
 
@@ -234,6 +235,7 @@ func (h *MockHook) PostDiff(id HookResourceIdentity, dk addrs.DeposedKey, action
 	h.PostDiffAction = action
 	h.PostDiffPriorState = priorState
 	h.PostDiffPlannedState = plannedNewState
+	h.PostDiffDeferred = deferred
 	return h.PostDiffReturn, h.PostDiffError
 }
 
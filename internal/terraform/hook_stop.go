@@ -37,7 +37,7 @@ func (h *stopHook) PreDiff(id HookResourceIdentity, dk addrs.DeposedKey, priorSt
 	return h.hook()
 }
 
-func (h *stopHook) PostDiff(id HookResourceIdentity, dk addrs.DeposedKey, action plans.Action, priorState, plannedNewState cty.Value, err error) (HookAction, error) {
+func (h *stopHook) PostDiff(id HookResourceIdentity, dk addrs.DeposedKey, action plans.Action, priorState, plannedNewState cty.Value, deferred bool, err error) (HookAction, error) {
 	return h.hook()
 }
 
@@ -72,7 +72,7 @@ func (h *testHook) PreDiff(id HookResourceIdentity, dk addrs.DeposedKey, priorSt
 	return HookActionContinue, nil
 }
 
-func (h *testHook) PostDiff(id HookResourceIdentity, dk addrs.DeposedKey, action plans.Action, priorState, plannedNewState cty.Value, err error) (HookAction, error) {
+func (h *testHook) PostDiff(id HookResourceIdentity, dk addrs.DeposedKey, action plans.Action, priorState, plannedNewState cty.Value, deferred bool, err error) (HookAction, error) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 	h.Calls = append(h.Calls, &testHookCall{"PostDiff", id.Addr.String()})
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import "github.com/hashicorp/terraform/internal/providers"
+
+// defaultImportRetryAttempts is the number of times Core will retry an
+// ImportResourceState call that the provider explicitly reports as
+// retryable, such as a transient failure looking up a resource by its
+// identity.
+const defaultImportRetryAttempts = 3
+
+// importResourceStateWithRetry calls provider.ImportResourceState, retrying
+// up to maxAttempts times in total if the provider reports the failure as
+// retryable (providers.ImportResourceStateResponse.Retryable). This is
+// primarily aimed at identity-based imports, where resolving an identity to
+// a resource can require a lookup against a remote API that may fail
+// transiently.
+//
+// Retryable is currently only ever set by providers.Interface
+// implementations that run in-process, since the tfplugin5 and tfplugin6
+// wire protocols have no equivalent field for GRPCProvider to decode; see
+// providers.ImportResourceStateResponse.Retryable. Out-of-process providers
+// therefore never trigger a retry here today.
+//
+// It does not retry when the response is deferred: a deferral means the
+// import can't proceed yet for a structural reason, such as the resource's
+// provider configuration still being unknown (as with the unknownProvider
+// stub), and retrying immediately wouldn't change that.
+//
+// maxAttempts must be at least 1; a value of 1 means no retries are
+// performed.
+func importResourceStateWithRetry(provider providers.Interface, req providers.ImportResourceStateRequest, maxAttempts int) providers.ImportResourceStateResponse {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	var resp providers.ImportResourceStateResponse
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		resp = provider.ImportResourceState(req)
+		if !resp.Diagnostics.HasErrors() {
+			return resp
+		}
+		if resp.Deferred != nil {
+			return resp
+		}
+		if !resp.Retryable {
+			return resp
+		}
+		// Otherwise, the provider told us this attempt's failure was
+		// transient, so loop around and try again.
+	}
+	return resp
+}
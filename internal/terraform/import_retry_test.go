@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/providers"
+	testing_provider "github.com/hashicorp/terraform/internal/providers/testing"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+func TestImportResourceStateWithRetry(t *testing.T) {
+	t.Run("succeeds after transient failures", func(t *testing.T) {
+		calls := 0
+		p := &testing_provider.MockProvider{
+			ConfigureProviderCalled: true,
+			ImportResourceStateFn: func(providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
+				calls++
+				if calls < 3 {
+					var diags tfdiags.Diagnostics
+					diags = diags.Append(tfdiags.Sourceless(tfdiags.Error, "identity lookup failed", "transient error"))
+					return providers.ImportResourceStateResponse{Diagnostics: diags, Retryable: true}
+				}
+				return providers.ImportResourceStateResponse{
+					ImportedResources: []providers.ImportedResource{{TypeName: "test_thing"}},
+				}
+			},
+		}
+
+		resp := importResourceStateWithRetry(p, providers.ImportResourceStateRequest{TypeName: "test_thing"}, 5)
+		if resp.Diagnostics.HasErrors() {
+			t.Fatalf("unexpected errors: %s", resp.Diagnostics.Err())
+		}
+		if calls != 3 {
+			t.Fatalf("expected 3 attempts, got %d", calls)
+		}
+	})
+
+	t.Run("gives up once retries are exhausted", func(t *testing.T) {
+		calls := 0
+		p := &testing_provider.MockProvider{
+			ConfigureProviderCalled: true,
+			ImportResourceStateFn: func(providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
+				calls++
+				var diags tfdiags.Diagnostics
+				diags = diags.Append(tfdiags.Sourceless(tfdiags.Error, "identity lookup failed", "transient error"))
+				return providers.ImportResourceStateResponse{Diagnostics: diags, Retryable: true}
+			},
+		}
+
+		resp := importResourceStateWithRetry(p, providers.ImportResourceStateRequest{TypeName: "test_thing"}, 3)
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected errors after exhausting retries")
+		}
+		if calls != 3 {
+			t.Fatalf("expected exactly maxAttempts=3 attempts, got %d", calls)
+		}
+	})
+
+	t.Run("does not retry non-retryable failures", func(t *testing.T) {
+		calls := 0
+		p := &testing_provider.MockProvider{
+			ConfigureProviderCalled: true,
+			ImportResourceStateFn: func(providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
+				calls++
+				var diags tfdiags.Diagnostics
+				diags = diags.Append(tfdiags.Sourceless(tfdiags.Error, "not found", "no such resource"))
+				return providers.ImportResourceStateResponse{Diagnostics: diags}
+			},
+		}
+
+		resp := importResourceStateWithRetry(p, providers.ImportResourceStateRequest{TypeName: "test_thing"}, 5)
+		if !resp.Diagnostics.HasErrors() {
+			t.Fatal("expected an error")
+		}
+		if calls != 1 {
+			t.Fatalf("expected exactly 1 attempt, got %d", calls)
+		}
+	})
+
+	t.Run("does not retry deferred responses", func(t *testing.T) {
+		calls := 0
+		p := &testing_provider.MockProvider{
+			ConfigureProviderCalled: true,
+			ImportResourceStateFn: func(providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
+				calls++
+				var diags tfdiags.Diagnostics
+				diags = diags.Append(tfdiags.Sourceless(tfdiags.Error, "not ready", "provider config unknown"))
+				return providers.ImportResourceStateResponse{
+					Diagnostics: diags,
+					Retryable:   true,
+					Deferred:    &providers.Deferred{Reason: providers.DeferredReasonProviderConfigUnknown},
+				}
+			},
+		}
+
+		resp := importResourceStateWithRetry(p, providers.ImportResourceStateRequest{TypeName: "test_thing"}, 5)
+		if resp.Deferred == nil {
+			t.Fatal("expected a deferred response")
+		}
+		if calls != 1 {
+			t.Fatalf("expected exactly 1 attempt for a deferred response, got %d", calls)
+		}
+	})
+}
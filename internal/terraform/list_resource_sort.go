@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// sortAndLimitListResults applies client-side sorting and limiting to the
+// "data" element of a ListResource result, for providers that don't support
+// pushing either of those operations down into the provider itself.
+//
+// results must be a cty value of list or tuple type whose elements are
+// objects. sortByAttr, if non-empty, names a top-level attribute of those
+// objects to sort ascending by; it must have a type that lessThan supports
+// (number, string, or bool). A limit of zero or
+// less leaves the result count unchanged.
+//
+// Callers that already asked the provider to apply a limit (via
+// ListResourceRequest.Limit) only need this for the sort, since the
+// provider will have already bounded the result count; passing that same
+// limit through here again is harmless, since a result set already at or
+// under the limit is left alone.
+func sortAndLimitListResults(results cty.Value, sortByAttr string, limit int64) (cty.Value, error) {
+	if results.IsNull() || !results.IsKnown() {
+		return results, nil
+	}
+
+	elems := results.AsValueSlice()
+
+	if sortByAttr != "" {
+		var sortErr error
+		sort.SliceStable(elems, func(i, j int) bool {
+			if sortErr != nil {
+				return false
+			}
+			a, err := attrForSort(elems[i], sortByAttr)
+			if err != nil {
+				sortErr = err
+				return false
+			}
+			b, err := attrForSort(elems[j], sortByAttr)
+			if err != nil {
+				sortErr = err
+				return false
+			}
+			less, err := lessThan(a, b)
+			if err != nil {
+				sortErr = err
+				return false
+			}
+			return less
+		})
+		if sortErr != nil {
+			return cty.NilVal, sortErr
+		}
+	}
+
+	if limit > 0 && int64(len(elems)) > limit {
+		elems = elems[:limit]
+	}
+
+	ty := results.Type()
+	if len(elems) == 0 {
+		if ty.IsListType() {
+			return cty.ListValEmpty(ty.ElementType()), nil
+		}
+		return cty.EmptyTupleVal, nil
+	}
+	if ty.IsListType() {
+		return cty.ListVal(elems), nil
+	}
+	return cty.TupleVal(elems), nil
+}
+
+func attrForSort(elem cty.Value, attr string) (cty.Value, error) {
+	ty := elem.Type()
+	if !ty.IsObjectType() || !ty.HasAttribute(attr) {
+		// display_name lives at the top level of a list result; everything
+		// else a provider returns about the resource is nested under state.
+		if !ty.IsObjectType() || !ty.HasAttribute("state") {
+			return cty.NilVal, fmt.Errorf("result has no attribute %q to sort by", attr)
+		}
+		state := elem.GetAttr("state")
+		stateTy := state.Type()
+		if !stateTy.IsObjectType() || !stateTy.HasAttribute(attr) {
+			return cty.NilVal, fmt.Errorf("result has no attribute %q to sort by", attr)
+		}
+		return state.GetAttr(attr), nil
+	}
+	return elem.GetAttr(attr), nil
+}
+
+// lessThan compares two scalar cty values of the same comparable type,
+// reporting whether a sorts before b. It supports the primitive types that
+// are realistically useful to sort list results by.
+func lessThan(a, b cty.Value) (bool, error) {
+	a, _ = a.Unmark()
+	b, _ = b.Unmark()
+
+	aMissing := !a.IsKnown() || a.IsNull()
+	bMissing := !b.IsKnown() || b.IsNull()
+	if aMissing || bMissing {
+		// Treat unknown or null values as sorting last, consistently with
+		// each other, rather than erroring out on a result set that simply
+		// hasn't populated every value yet.
+		return !aMissing && bMissing, nil
+	}
+
+	switch a.Type() {
+	case cty.Number:
+		af, _ := a.AsBigFloat().Float64()
+		bf, _ := b.AsBigFloat().Float64()
+		return af < bf, nil
+	case cty.String:
+		return a.AsString() < b.AsString(), nil
+	case cty.Bool:
+		return !a.True() && b.True(), nil
+	default:
+		return false, fmt.Errorf("cannot sort by a value of type %s", a.Type().FriendlyName())
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func listResourceSortFixture() cty.Value {
+	return cty.ListVal([]cty.Value{
+		cty.ObjectVal(map[string]cty.Value{
+			"name": cty.StringVal("charlie"),
+			"size": cty.NumberIntVal(30),
+		}),
+		cty.ObjectVal(map[string]cty.Value{
+			"name": cty.StringVal("alice"),
+			"size": cty.NumberIntVal(10),
+		}),
+		cty.ObjectVal(map[string]cty.Value{
+			"name": cty.StringVal("bob"),
+			"size": cty.NumberIntVal(20),
+		}),
+	})
+}
+
+func TestSortAndLimitListResults(t *testing.T) {
+	t.Run("sorts client-side by a string attribute", func(t *testing.T) {
+		got, err := sortAndLimitListResults(listResourceSortFixture(), "name", 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		names := namesOf(t, got)
+		want := []string{"alice", "bob", "charlie"}
+		if !stringSlicesEqual(names, want) {
+			t.Fatalf("wrong order: got %v, want %v", names, want)
+		}
+	})
+
+	t.Run("sorts client-side by a number attribute", func(t *testing.T) {
+		got, err := sortAndLimitListResults(listResourceSortFixture(), "size", 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		names := namesOf(t, got)
+		want := []string{"alice", "bob", "charlie"}
+		if !stringSlicesEqual(names, want) {
+			t.Fatalf("wrong order: got %v, want %v", names, want)
+		}
+	})
+
+	t.Run("applies a client-side limit as a fallback", func(t *testing.T) {
+		got, err := sortAndLimitListResults(listResourceSortFixture(), "name", 2)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		names := namesOf(t, got)
+		want := []string{"alice", "bob"}
+		if !stringSlicesEqual(names, want) {
+			t.Fatalf("wrong result: got %v, want %v", names, want)
+		}
+	})
+
+	t.Run("limit is a no-op when the provider already pushed it down", func(t *testing.T) {
+		alreadyLimited := cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("alice")}),
+		})
+		got, err := sortAndLimitListResults(alreadyLimited, "", 5)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got.LengthInt() != 1 {
+			t.Fatalf("expected the already-limited result to be left alone, got %#v", got)
+		}
+	})
+
+	t.Run("errors when sorting by an attribute that doesn't exist", func(t *testing.T) {
+		_, err := sortAndLimitListResults(listResourceSortFixture(), "nonexistent", 0)
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
+
+// realListResourceSortFixture mirrors the shape a provider actually returns
+// for each list result: display_name and identity at the top level, with the
+// resource's own attributes nested under state. sort_by needs to be able to
+// find attributes there too, not just in hand-built flat fixtures.
+func realListResourceSortFixture() cty.Value {
+	return cty.ListVal([]cty.Value{
+		cty.ObjectVal(map[string]cty.Value{
+			"display_name": cty.StringVal("charlie"),
+			"identity":     cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("3")}),
+			"state": cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("charlie"),
+				"size": cty.NumberIntVal(30),
+			}),
+		}),
+		cty.ObjectVal(map[string]cty.Value{
+			"display_name": cty.StringVal("alice"),
+			"identity":     cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("1")}),
+			"state": cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("alice"),
+				"size": cty.NumberIntVal(10),
+			}),
+		}),
+		cty.ObjectVal(map[string]cty.Value{
+			"display_name": cty.StringVal("bob"),
+			"identity":     cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("2")}),
+			"state": cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("bob"),
+				"size": cty.NumberIntVal(20),
+			}),
+		}),
+	})
+}
+
+func TestSortAndLimitListResults_realResultShape(t *testing.T) {
+	t.Run("sorts by an attribute nested under state", func(t *testing.T) {
+		got, err := sortAndLimitListResults(realListResourceSortFixture(), "size", 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		var names []string
+		for _, elem := range got.AsValueSlice() {
+			names = append(names, elem.GetAttr("display_name").AsString())
+		}
+		want := []string{"alice", "bob", "charlie"}
+		if !stringSlicesEqual(names, want) {
+			t.Fatalf("wrong order: got %v, want %v", names, want)
+		}
+	})
+
+	t.Run("sorts by display_name at the top level", func(t *testing.T) {
+		got, err := sortAndLimitListResults(realListResourceSortFixture(), "display_name", 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		var names []string
+		for _, elem := range got.AsValueSlice() {
+			names = append(names, elem.GetAttr("display_name").AsString())
+		}
+		want := []string{"alice", "bob", "charlie"}
+		if !stringSlicesEqual(names, want) {
+			t.Fatalf("wrong order: got %v, want %v", names, want)
+		}
+	})
+}
+
+func namesOf(t *testing.T, results cty.Value) []string {
+	t.Helper()
+	var names []string
+	for _, elem := range results.AsValueSlice() {
+		names = append(names, elem.GetAttr("name").AsString())
+	}
+	return names
+}
+
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
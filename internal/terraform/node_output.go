@@ -868,6 +868,12 @@ func (n *NodeApplyableOutput) setValue(namedVals *namedvals.State, state *states
 			} else {
 				val = cty.UnknownAsNull(val)
 			}
+		} else if deferred.DependenciesDeferred(n.Dependencies) {
+			// A module output that depends on a deferred resource can't
+			// really be computed yet either, so we taint it the same way as
+			// a deferred root output above, just without stripping the
+			// marks that non-root outputs are allowed to keep.
+			val = cty.NullVal(val.Type()).WithMarks(val.Marks())
 		}
 		state.SetOutputValue(n.Addr, val, n.Config.Sensitive)
 	}
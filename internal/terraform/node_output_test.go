@@ -14,7 +14,9 @@ import (
 	"github.com/hashicorp/terraform/internal/checks"
 	"github.com/hashicorp/terraform/internal/configs"
 	"github.com/hashicorp/terraform/internal/lang/marks"
+	"github.com/hashicorp/terraform/internal/plans"
 	"github.com/hashicorp/terraform/internal/plans/deferring"
+	"github.com/hashicorp/terraform/internal/providers"
 	"github.com/hashicorp/terraform/internal/states"
 )
 
@@ -157,6 +159,53 @@ func TestNodeApplyableOutputExecute_sensitiveValueAndOutput(t *testing.T) {
 	}
 }
 
+func TestNodeApplyableOutputExecute_deferredDependencyInChildModule(t *testing.T) {
+	ctx := new(MockEvalContext)
+	ctx.StateState = states.NewState().SyncWrapper()
+	ctx.RefreshStateState = states.NewState().SyncWrapper()
+	ctx.ChecksState = checks.NewState(nil)
+
+	deferredAddr := addrs.AbsResourceInstance{
+		Module: addrs.RootModuleInstance.Child("child", addrs.NoKey),
+		Resource: addrs.ResourceInstance{
+			Resource: addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "test_instance",
+				Name: "deferred",
+			},
+		},
+	}
+	deferred := deferring.NewDeferred(true)
+	deferred.ReportResourceInstanceDeferred(deferredAddr, providers.DeferredReasonResourceConfigUnknown, &plans.ResourceInstanceChange{
+		Addr: deferredAddr,
+		Change: plans.Change{
+			Action: plans.Create,
+			After:  cty.DynamicVal,
+		},
+	})
+	ctx.DeferralsState = deferred
+
+	config := &configs.Output{Name: "result", ConstraintType: cty.DynamicPseudoType}
+	addr := addrs.OutputValue{Name: config.Name}.Absolute(addrs.RootModuleInstance.Child("child", addrs.NoKey))
+	node := &NodeApplyableOutput{
+		Config:       config,
+		Addr:         addr,
+		Dependencies: []addrs.ConfigResource{deferredAddr.ConfigResource()},
+	}
+	val := cty.StringVal("known-for-now")
+	ctx.EvaluateExprResult = val
+
+	err := node.Execute(ctx, walkApply)
+	if err != nil {
+		t.Fatalf("unexpected execute error: %s", err)
+	}
+
+	outputVal := ctx.StateState.OutputValue(addr)
+	if !outputVal.Value.IsNull() {
+		t.Errorf("expected a deferred module output to be stored as null, got %#v", outputVal.Value)
+	}
+}
+
 func TestNodeDestroyableOutputExecute(t *testing.T) {
 	outputAddr := addrs.OutputValue{Name: "foo"}.Absolute(addrs.RootModuleInstance)
 
@@ -204,6 +204,16 @@ func (n *NodeApplyableProvider) ConfigureProvider(ctx EvalContext, provider prov
 		))
 	}
 
+	if config != nil && config.DefaultTags != nil {
+		tagsVal, tagsDiags := ctx.EvaluateExpr(config.DefaultTags, cty.DynamicPseudoType, nil)
+		diags = diags.Append(tagsDiags)
+		if tagsDiags.HasErrors() {
+			return diags
+		}
+		unmarkedTagsVal, _ := tagsVal.UnmarkDeep()
+		ctx.SetProviderDefaultTags(n.Addr, unmarkedTagsVal)
+	}
+
 	// Post-provider config policy evaluation
 	//
 	// We use the marked "configVal" so that we can send sensitive paths to the
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hcltest"
 	"github.com/zclconf/go-cty/cty"
 
 	"github.com/hashicorp/terraform/internal/addrs"
@@ -555,6 +556,85 @@ func TestNodeApplyableProvider_ConfigProvider_config_fn_err(t *testing.T) {
 	})
 }
 
+func TestNodeApplyableProvider_ConfigProvider_defaultTags(t *testing.T) {
+	provider := mockProviderWithConfigSchema(&configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"region": {
+				Type:     cty.String,
+				Optional: true,
+			},
+		},
+	})
+
+	t.Run("default tags are recorded", func(t *testing.T) {
+		config := &configs.Provider{
+			Name: "test",
+			Config: configs.SynthBody("", map[string]cty.Value{
+				"region": cty.StringVal("mars"),
+			}),
+			DefaultTags: hcltest.MockExprLiteral(cty.MapVal(map[string]cty.Value{
+				"environment": cty.StringVal("production"),
+			})),
+		}
+
+		node := NodeApplyableProvider{
+			NodeAbstractProvider: &NodeAbstractProvider{
+				Addr:   mustProviderConfig(`provider["registry.terraform.io/hashicorp/aws"]`),
+				Config: config,
+			},
+		}
+
+		ctx := &MockEvalContext{ProviderProvider: provider}
+		ctx.installSimpleEval()
+
+		diags := node.ConfigureProvider(ctx, provider, false)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected error: %s", diags.Err())
+		}
+
+		if !ctx.SetProviderDefaultTagsCalled {
+			t.Fatal("SetProviderDefaultTags was not called")
+		}
+		if got, want := ctx.SetProviderDefaultTagsAddr, node.Addr; !got.Equal(want) {
+			t.Errorf("wrong provider address\ngot:  %s\nwant: %s", got, want)
+		}
+		want := cty.MapVal(map[string]cty.Value{
+			"environment": cty.StringVal("production"),
+		})
+		if got := ctx.SetProviderDefaultTagsValue; !got.RawEquals(want) {
+			t.Errorf("wrong default tags value\ngot:  %#v\nwant: %#v", got, want)
+		}
+	})
+
+	t.Run("no default_tags argument leaves defaults unset", func(t *testing.T) {
+		config := &configs.Provider{
+			Name: "test",
+			Config: configs.SynthBody("", map[string]cty.Value{
+				"region": cty.StringVal("mars"),
+			}),
+		}
+
+		node := NodeApplyableProvider{
+			NodeAbstractProvider: &NodeAbstractProvider{
+				Addr:   mustProviderConfig(`provider["registry.terraform.io/hashicorp/aws"]`),
+				Config: config,
+			},
+		}
+
+		ctx := &MockEvalContext{ProviderProvider: provider}
+		ctx.installSimpleEval()
+
+		diags := node.ConfigureProvider(ctx, provider, false)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected error: %s", diags.Err())
+		}
+
+		if ctx.SetProviderDefaultTagsCalled {
+			t.Fatal("SetProviderDefaultTags should not have been called")
+		}
+	})
+}
+
 func TestGetSchemaError(t *testing.T) {
 	provider := &testing_provider.MockProvider{
 		GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
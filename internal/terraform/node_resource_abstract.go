@@ -6,6 +6,7 @@ package terraform
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/internal/addrs"
 	"github.com/hashicorp/terraform/internal/configs"
@@ -165,6 +166,61 @@ func (n *NodeAbstractResource) ForceCreateBeforeDestroy() {
 	n.forceCreateBeforeDestroy = true
 }
 
+// ApplySerial returns true if this resource's lifecycle block requests
+// that its instances never be applied concurrently with one another, or
+// with any other resource that has the same setting.
+func (n *NodeAbstractResource) ApplySerial() bool {
+	if n.Config != nil && n.Config.Managed != nil {
+		return n.Config.Managed.ApplySerial
+	}
+
+	return false
+}
+
+// MaxParallel returns the configured limit on how many instances of this
+// resource's for_each (or count) expansion may be applied concurrently, or
+// zero if its lifecycle block doesn't configure one.
+func (n *NodeAbstractResource) MaxParallel() int {
+	if n.Config != nil && n.Config.Managed != nil {
+		return n.Config.Managed.MaxParallel
+	}
+
+	return 0
+}
+
+// ApplyTimeout returns the configured bound on how long to wait for this
+// resource's provider apply calls to return before warning that the apply
+// ran long, or zero if its lifecycle block doesn't configure one.
+func (n *NodeAbstractResource) ApplyTimeout() time.Duration {
+	if n.Config != nil && n.Config.Managed != nil {
+		return n.Config.Managed.ApplyTimeout
+	}
+
+	return 0
+}
+
+// RequireApplyConfirmation returns true if this resource's lifecycle block
+// flags its instances as requiring a provider's confirmation before apply,
+// for providers that implement providers.ApplyConfirmer.
+func (n *NodeAbstractResource) RequireApplyConfirmation() bool {
+	if n.Config != nil && n.Config.Managed != nil {
+		return n.Config.Managed.RequireApplyConfirmation
+	}
+
+	return false
+}
+
+// UseProviderTransaction returns true if this resource's lifecycle block
+// flags its instances' apply calls as requiring a provider-level
+// transaction, for providers that implement providers.Transactional.
+func (n *NodeAbstractResource) UseProviderTransaction() bool {
+	if n.Config != nil && n.Config.Managed != nil {
+		return n.Config.Managed.UseProviderTransaction
+	}
+
+	return false
+}
+
 // GraphNodeReferencer
 func (n *NodeAbstractResource) References() []*addrs.Reference {
 	var result []*addrs.Reference
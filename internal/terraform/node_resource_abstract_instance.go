@@ -55,26 +55,46 @@ type NodeAbstractResourceInstance struct {
 
 	// override is set by the graph itself, just before this node executes.
 	override *configs.Override
+
+	// applyResultCache, if set, is consulted by apply before calling an
+	// idempotent provider's ApplyResourceChange and updated with the result
+	// afterwards, so that a repeated apply of the same change against the
+	// same instance within one operation can skip the provider round-trip.
+	// It's nil unless the running operation's ContextOpts set
+	// ReuseIdempotentApplyResults, in which case Graph.walk attaches the
+	// operation's shared cache via SetApplyResultCache just before this node
+	// executes.
+	applyResultCache *applyResultCache
+
+	// providerApplyRateLimiter, if set, is consulted by apply before calling
+	// the provider's ApplyResourceChange, to space out applies against this
+	// instance's provider. It's nil unless the running operation's
+	// ContextOpts set ProviderApplyRateLimits, in which case Graph.walk
+	// attaches the operation's shared limiter via SetProviderApplyRateLimiter
+	// just before this node executes.
+	providerApplyRateLimiter *ProviderApplyRateLimiter
 }
 
 var (
-	_ GraphNodeModuleInstance            = (*NodeAbstractResourceInstance)(nil)
-	_ GraphNodeReferenceable             = (*NodeAbstractResourceInstance)(nil)
-	_ GraphNodeReferencer                = (*NodeAbstractResourceInstance)(nil)
-	_ GraphNodeProviderConsumer          = (*NodeAbstractResourceInstance)(nil)
-	_ GraphNodeProvisionerConsumer       = (*NodeAbstractResourceInstance)(nil)
-	_ GraphNodeConfigResource            = (*NodeAbstractResourceInstance)(nil)
-	_ GraphNodeResourceInstance          = (*NodeAbstractResourceInstance)(nil)
-	_ GraphNodeAttachResourceState       = (*NodeAbstractResourceInstance)(nil)
-	_ GraphNodeActionInvoker             = (*NodeAbstractResourceInstance)(nil)
-	_ GraphNodeActionCaller              = (*NodeAbstractResourceInstance)(nil)
-	_ GraphNodeAttachResourceConfig      = (*NodeAbstractResourceInstance)(nil)
-	_ GraphNodeAttachResourceSchema      = (*NodeAbstractResourceInstance)(nil)
-	_ GraphNodeAttachProvisionerSchema   = (*NodeAbstractResourceInstance)(nil)
-	_ GraphNodeAttachProviderMetaConfigs = (*NodeAbstractResourceInstance)(nil)
-	_ GraphNodeTargetable                = (*NodeAbstractResourceInstance)(nil)
-	_ GraphNodeOverridable               = (*NodeAbstractResourceInstance)(nil)
-	_ dag.GraphNodeDotter                = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeModuleInstance                   = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeReferenceable                    = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeReferencer                       = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeProviderConsumer                 = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeProvisionerConsumer              = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeConfigResource                   = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeResourceInstance                 = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeAttachResourceState              = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeActionInvoker                    = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeActionCaller                     = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeAttachResourceConfig             = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeAttachResourceSchema             = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeAttachProvisionerSchema          = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeAttachProviderMetaConfigs        = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeTargetable                       = (*NodeAbstractResourceInstance)(nil)
+	_ GraphNodeOverridable                      = (*NodeAbstractResourceInstance)(nil)
+	_ graphNodeApplyResultCacheConsumer         = (*NodeAbstractResourceInstance)(nil)
+	_ graphNodeProviderApplyRateLimiterConsumer = (*NodeAbstractResourceInstance)(nil)
+	_ dag.GraphNodeDotter                       = (*NodeAbstractResourceInstance)(nil)
 )
 
 // NewNodeAbstractResourceInstance creates an abstract resource instance graph
@@ -205,6 +225,15 @@ func (n *NodeAbstractResourceInstance) SetOverride(override *configs.Override) {
 	n.override = override
 }
 
+// graphNodeApplyResultCacheConsumer
+func (n *NodeAbstractResourceInstance) SetApplyResultCache(cache *applyResultCache) {
+	n.applyResultCache = cache
+}
+
+func (n *NodeAbstractResourceInstance) SetProviderApplyRateLimiter(limiter *ProviderApplyRateLimiter) {
+	n.providerApplyRateLimiter = limiter
+}
+
 func (n *NodeAbstractResourceInstance) checkPreventDestroy(change *plans.ResourceInstanceChange) tfdiags.Diagnostics {
 	if change == nil || n.Config == nil || n.Config.Managed == nil {
 		return nil
@@ -506,7 +535,7 @@ func (n *NodeAbstractResourceInstance) planDestroy(ctx EvalContext, currentState
 		diags = diags.Append(resp.Diagnostics)
 		if diags.HasErrors() {
 			diags = diags.Append(ctx.Hook(func(h Hook) (HookAction, error) {
-				return h.PostDiff(n.HookResourceIdentity(), deposedKey, plans.Delete, currentState.Value, nullVal, diags.Err())
+				return h.PostDiff(n.HookResourceIdentity(), deposedKey, plans.Delete, currentState.Value, nullVal, deferred != nil, diags.Err())
 			}))
 			return plan, deferred, diags
 		}
@@ -528,7 +557,7 @@ func (n *NodeAbstractResourceInstance) planDestroy(ctx EvalContext, currentState
 
 	// Call post-refresh hook
 	diags = diags.Append(ctx.Hook(func(h Hook) (HookAction, error) {
-		return h.PostDiff(n.HookResourceIdentity(), deposedKey, plans.Delete, currentState.Value, nullVal, nil)
+		return h.PostDiff(n.HookResourceIdentity(), deposedKey, plans.Delete, currentState.Value, nullVal, deferred != nil, nil)
 	}))
 	if diags.HasErrors() {
 		return plan, deferred, diags
@@ -807,6 +836,10 @@ func (n *NodeAbstractResourceInstance) refresh(ctx EvalContext, deposedKey state
 	ret.Private = resp.Private
 	ret.Identity = resp.Identity
 
+	if decideDriftRemediation(deferred != nil, priorVal, ret.Value) == driftRemediationUpdate {
+		log.Printf("[TRACE] NodeAbstractResourceInstance.refresh: %s has drifted from its configuration and will be corrected during apply", absAddr)
+	}
+
 	// Call post-refresh hook
 	diags = diags.Append(ctx.Hook(func(h Hook) (HookAction, error) {
 		return h.PostRefresh(n.HookResourceIdentity(), deposedKey, priorVal, ret.Value)
@@ -914,6 +947,12 @@ func (n *NodeAbstractResourceInstance) plan(
 		return nil, nil, deferred, diags
 	}
 
+	if _, ok := schema.Body.Attributes["tags"]; ok {
+		if defaultTags := ctx.ProviderDefaultTags(n.ResolvedProvider); defaultTags != cty.NilVal {
+			origConfigVal = mergeProviderDefaultTags(origConfigVal, defaultTags)
+		}
+	}
+
 	metaConfigVal, metaDiags := n.Provider().getProviderMeta(ctx, n.Addr.Resource, n.ProviderMetas)
 	diags = diags.Append(metaDiags)
 	if diags.HasErrors() {
@@ -1043,7 +1082,7 @@ func (n *NodeAbstractResourceInstance) plan(
 	diags = diags.Append(resp.Diagnostics.InConfigBody(config.Config, n.Addr.String()))
 	if diags.HasErrors() {
 		diags = diags.Append(ctx.Hook(func(h Hook) (HookAction, error) {
-			return h.PostDiff(n.HookResourceIdentity(), addrs.NotDeposed, plans.Read, priorVal, proposedNewVal, diags.Err())
+			return h.PostDiff(n.HookResourceIdentity(), addrs.NotDeposed, plans.Read, priorVal, proposedNewVal, deferred != nil, diags.Err())
 		}))
 		return nil, nil, deferred, diags
 	}
@@ -1262,7 +1301,7 @@ func (n *NodeAbstractResourceInstance) plan(
 		if resp.Diagnostics.HasErrors() {
 			diags = diags.Append(resp.Diagnostics.InConfigBody(config.Config, n.Addr.String()))
 			diags = diags.Append(ctx.Hook(func(h Hook) (HookAction, error) {
-				return h.PostDiff(n.HookResourceIdentity(), addrs.NotDeposed, plans.Read, priorVal, proposedNewVal, diags.Err())
+				return h.PostDiff(n.HookResourceIdentity(), addrs.NotDeposed, plans.Read, priorVal, proposedNewVal, deferred != nil, diags.Err())
 			}))
 			return nil, nil, deferred, diags
 		}
@@ -1291,7 +1330,7 @@ func (n *NodeAbstractResourceInstance) plan(
 		}
 		if diags.HasErrors() {
 			diags = diags.Append(ctx.Hook(func(h Hook) (HookAction, error) {
-				return h.PostDiff(n.HookResourceIdentity(), addrs.NotDeposed, plans.Read, priorVal, proposedNewVal, diags.Err())
+				return h.PostDiff(n.HookResourceIdentity(), addrs.NotDeposed, plans.Read, priorVal, proposedNewVal, deferred != nil, diags.Err())
 			}))
 			return nil, nil, deferred, diags
 		}
@@ -1312,7 +1351,7 @@ func (n *NodeAbstractResourceInstance) plan(
 
 		if writeOnlyDiags.HasErrors() {
 			diags = diags.Append(ctx.Hook(func(h Hook) (HookAction, error) {
-				return h.PostDiff(n.HookResourceIdentity(), addrs.NotDeposed, plans.Read, priorVal, proposedNewVal, diags.Err())
+				return h.PostDiff(n.HookResourceIdentity(), addrs.NotDeposed, plans.Read, priorVal, proposedNewVal, deferred != nil, diags.Err())
 			}))
 			return nil, nil, deferred, diags
 		}
@@ -1364,7 +1403,7 @@ func (n *NodeAbstractResourceInstance) plan(
 
 	// Call post-refresh hook
 	diags = diags.Append(ctx.Hook(func(h Hook) (HookAction, error) {
-		return h.PostDiff(n.HookResourceIdentity(), addrs.NotDeposed, action, priorVal, plannedNewVal, nil)
+		return h.PostDiff(n.HookResourceIdentity(), addrs.NotDeposed, action, priorVal, plannedNewVal, deferred != nil, nil)
 	}))
 	if diags.HasErrors() {
 		return nil, nil, deferred, diags
@@ -1983,7 +2022,7 @@ func (n *NodeAbstractResourceInstance) planDataSource(ctx EvalContext, checkRule
 		}
 
 		diags = diags.Append(ctx.Hook(func(h Hook) (HookAction, error) {
-			return h.PostDiff(n.HookResourceIdentity(), addrs.NotDeposed, plans.Read, priorVal, proposedNewVal, nil)
+			return h.PostDiff(n.HookResourceIdentity(), addrs.NotDeposed, plans.Read, priorVal, proposedNewVal, deferred != nil, nil)
 		}))
 
 		return plannedChange, plannedNewState, deferred, keyData, diags
@@ -2567,6 +2606,50 @@ func (n *NodeAbstractResourceInstance) evalDestroyProvisionerConfig(ctx EvalCont
 	return config, diags
 }
 
+// preDestructiveApplySnapshot returns an independent copy of state, captured
+// immediately before a destructive apply -- one that deletes the prior
+// object outright, or replaces it with a new one -- so that the prior
+// object's data is still intact somewhere even if the apply fails partway
+// through and the in-place copy of state ends up overwritten or discarded.
+//
+// It returns nil for actions that don't destroy the prior object, and for a
+// state that has nothing in it to lose, since there's nothing worth
+// snapshotting in either case. A provider that never performs a real
+// destructive apply -- such as the unknownProvider stub, whose
+// ApplyResourceChange always errors before anything is destroyed -- never
+// has anything to recover either, so it's naturally exempt without needing
+// a special case here.
+func (n *NodeAbstractResourceInstance) preDestructiveApplySnapshot(state *states.ResourceInstanceObject, action plans.Action) *states.ResourceInstanceObject {
+	if state == nil || state.Value.IsNull() {
+		return nil
+	}
+	if action != plans.Delete && !action.IsReplace() {
+		return nil
+	}
+	return state.DeepCopy()
+}
+
+// applyResultCacheGet returns a cached ApplyResourceChange response for
+// change, if n.applyResultCache is set and already has one. A no-op change
+// is never looked up, since apply never reaches this far for one.
+func (n *NodeAbstractResourceInstance) applyResultCacheGet(change *plans.ResourceInstanceChange) (providers.ApplyResourceChangeResponse, bool) {
+	if n.applyResultCache == nil || change.Action == plans.NoOp {
+		return providers.ApplyResourceChangeResponse{}, false
+	}
+	return n.applyResultCache.get(n.Addr, change)
+}
+
+// applyResultCachePut records resp as the result of applying change, if
+// n.applyResultCache is set. A response with error diagnostics is never
+// cached, so that a failed apply is always retried against the real
+// provider rather than replaying the same failure indefinitely.
+func (n *NodeAbstractResourceInstance) applyResultCachePut(change *plans.ResourceInstanceChange, resp providers.ApplyResourceChangeResponse) {
+	if n.applyResultCache == nil || change.Action == plans.NoOp || resp.Diagnostics.HasErrors() {
+		return
+	}
+	n.applyResultCache.put(n.Addr, change, resp)
+}
+
 // apply accepts an applyConfig, instead of using n.Config, so destroy plans can
 // send a nil config. The keyData information can be empty if the config is
 // nil, since it is only used to evaluate the configuration.
@@ -2694,15 +2777,64 @@ func (n *NodeAbstractResourceInstance) apply(
 			}
 		}
 	} else {
-		resp = provider.ApplyResourceChange(providers.ApplyResourceChangeRequest{
-			TypeName:        n.Addr.Resource.Resource.Type,
-			PriorState:      unmarkedBefore,
-			Config:          unmarkedConfigVal,
-			PlannedState:    unmarkedAfter,
-			PlannedPrivate:  change.Private,
-			ProviderMeta:    metaConfigVal,
-			PlannedIdentity: change.AfterIdentity,
-		})
+		if snapshot := n.preDestructiveApplySnapshot(state, change.Action); snapshot != nil {
+			// We don't yet have anywhere durable to put this snapshot --
+			// Core has no per-resource recovery store -- so for now this
+			// just gets us a breadcrumb in the log to confirm the object
+			// we're about to destroy or replace was captured before the
+			// provider touched it.
+			log.Printf("[DEBUG] %s: captured a pre-apply state snapshot before %s, in case the apply fails", n.Addr, change.Action)
+		}
+
+		cached, cacheHit := n.applyResultCacheGet(change)
+		if cacheHit {
+			log.Printf("[DEBUG] %s: reusing a cached apply result for this change instead of calling the provider again", n.Addr)
+			resp = cached
+		} else {
+			if n.providerApplyRateLimiter != nil {
+				if err := n.providerApplyRateLimiter.Wait(ctx.StopCtx(), n.ResolvedProvider.Provider); err != nil {
+					diags = diags.Append(err)
+					return state, diags
+				}
+			}
+
+			confirmDiags := confirmApplyIfRequired(provider, n.RequireApplyConfirmation(), n.Addr.Resource.Resource.Type, unmarkedBefore, unmarkedAfter)
+			diags = diags.Append(confirmDiags)
+			if confirmDiags.HasErrors() {
+				return state, diags
+			}
+
+			req := providers.ApplyResourceChangeRequest{
+				TypeName:        n.Addr.Resource.Resource.Type,
+				PriorState:      unmarkedBefore,
+				Config:          unmarkedConfigVal,
+				PlannedState:    unmarkedAfter,
+				PlannedPrivate:  change.Private,
+				ProviderMeta:    metaConfigVal,
+				PlannedIdentity: change.AfterIdentity,
+			}
+			callProvider := func() providers.ApplyResourceChangeResponse {
+				if timeout := n.ApplyTimeout(); timeout > 0 {
+					resp, timedOut := applyResourceChangeWithTimeout(provider, req, timeout)
+					if timedOut {
+						log.Printf("[WARN] %s: apply exceeded its configured apply_timeout of %s", n.Addr, timeout)
+					}
+					return resp
+				}
+				return provider.ApplyResourceChange(req)
+			}
+
+			if n.UseProviderTransaction() {
+				txDiags := applyWithProviderTransaction(provider, func() (bool, tfdiags.Diagnostics) {
+					resp = callProvider()
+					return !resp.Diagnostics.HasErrors(), resp.Diagnostics
+				})
+				resp.Diagnostics = txDiags
+			} else {
+				resp = callProvider()
+			}
+			n.applyResultCachePut(change, resp)
+		}
 
 		if !resp.NewIdentity.IsNull() {
 			diags = diags.Append(n.validateIdentityKnown(resp.NewIdentity))
@@ -3137,20 +3269,28 @@ func getRequiredReplaces(priorVal, plannedNewVal cty.Value, writeOnly []cty.Path
 }
 
 func (n *NodeAbstractResourceInstance) reportDeferredActionTriggers(ctx EvalContext, reason providers.DeferredReason) {
+	for blockIdx, trigger := range n.actionTriggers {
+		n.reportActionTriggerDeferred(ctx, blockIdx, trigger, reason)
+	}
+}
+
+// reportActionTriggerDeferred reports every action referenced by a single
+// action trigger block as deferred, for the given reason. blockIdx must be
+// this trigger's index into n.actionTriggers, so that the recorded
+// ActionInvocationInstance addresses line back up with the configuration.
+func (n *NodeAbstractResourceInstance) reportActionTriggerDeferred(ctx EvalContext, blockIdx int, trigger *resourceActionTrigger, reason providers.DeferredReason) {
 	deferrals := ctx.Deferrals()
 
-	for blockIdx, trigger := range n.actionTriggers {
-		for listIdx, action := range trigger.actionRefs {
-			deferrals.ReportActionInvocationDeferred(plans.ActionInvocationInstance{
-				Addr: action.actionNode.Addr.Absolute(n.Addr.Module).Instance(addrs.NoKey),
-				ActionTrigger: &plans.ResourceActionTrigger{
-					TriggeringResourceAddr:  n.Addr,
-					ActionTriggerBlockIndex: blockIdx,
-					ActionsListIndex:        listIdx,
-				},
-				Caller: n.Addr.Resource,
-			}, reason)
-		}
+	for listIdx, action := range trigger.actionRefs {
+		deferrals.ReportActionInvocationDeferred(plans.ActionInvocationInstance{
+			Addr: action.actionNode.Addr.Absolute(n.Addr.Module).Instance(addrs.NoKey),
+			ActionTrigger: &plans.ResourceActionTrigger{
+				TriggeringResourceAddr:  n.Addr,
+				ActionTriggerBlockIndex: blockIdx,
+				ActionsListIndex:        listIdx,
+			},
+			Caller: n.Addr.Resource,
+		}, reason)
 	}
 }
 
@@ -3167,7 +3307,7 @@ func (n *NodeAbstractResourceInstance) planActionTriggers(ctx EvalContext, resRe
 	// and record them at the end
 	var actionInvocations []*plans.ActionInvocationInstance
 
-	for _, trigger := range n.actionTriggers {
+	for blockIdx, trigger := range n.actionTriggers {
 		scope := ctx.EvaluationScope(n.Addr.Resource, nil, resRepData)
 		if trigger.config.Condition != nil {
 			cond, conditionEvalDiags := scope.EvalExpr(trigger.config.Condition, cty.Bool)
@@ -3176,7 +3316,18 @@ func (n *NodeAbstractResourceInstance) planActionTriggers(ctx EvalContext, resRe
 				continue
 			}
 
-			if cond.IsKnown() && cond.False() {
+			if !cond.IsKnown() {
+				// The condition depends on a value that isn't known yet,
+				// such as an output value computed from a resource that's
+				// still only planned, so we can't yet tell whether this
+				// trigger's actions should run. Defer them rather than
+				// guessing, so they get reconsidered once the condition's
+				// inputs are known.
+				n.reportActionTriggerDeferred(ctx, blockIdx, trigger, providers.DeferredReasonDeferredPrereq)
+				continue
+			}
+
+			if cond.False() {
 				// if we know the condition is going to be false, there's no need to
 				// even plan the action.
 				continue
@@ -3457,3 +3608,38 @@ func resourceLifecycleForget(config *configs.Resource) bool {
 	}
 	return false
 }
+
+// mergeProviderDefaultTags merges the given provider-level default tags into
+// the "tags" attribute of configVal, for resource types whose schema
+// declares a top-level "tags" attribute of a map-like type. Any tag key
+// already set in the resource's own configuration takes precedence over the
+// corresponding default.
+func mergeProviderDefaultTags(configVal cty.Value, defaultTags cty.Value) cty.Value {
+	if configVal.IsNull() || !configVal.IsKnown() {
+		return configVal
+	}
+	if defaultTags.IsNull() || !defaultTags.IsKnown() || !defaultTags.CanIterateElements() {
+		return configVal
+	}
+
+	attrs := configVal.AsValueMap()
+	resourceTags := map[string]cty.Value{}
+	if existing, ok := attrs["tags"]; ok && !existing.IsNull() && existing.IsKnown() && existing.CanIterateElements() {
+		resourceTags = existing.AsValueMap()
+	}
+
+	merged := map[string]cty.Value{}
+	for k, v := range defaultTags.AsValueMap() {
+		merged[k] = v
+	}
+	for k, v := range resourceTags {
+		merged[k] = v
+	}
+
+	if len(merged) == 0 {
+		return configVal
+	}
+
+	attrs["tags"] = cty.MapVal(merged)
+	return cty.ObjectVal(attrs)
+}
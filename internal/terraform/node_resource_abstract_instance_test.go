@@ -6,6 +6,7 @@ package terraform
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/zclconf/go-cty/cty"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform/internal/plans"
 	"github.com/hashicorp/terraform/internal/plans/deferring"
 	"github.com/hashicorp/terraform/internal/providers"
+	testing_provider "github.com/hashicorp/terraform/internal/providers/testing"
 	"github.com/hashicorp/terraform/internal/states"
 	"github.com/hashicorp/terraform/internal/tfdiags"
 )
@@ -315,3 +317,485 @@ func TestNodeAbstractResourceInstance_apply_with_unknown_values(t *testing.T) {
 		t.Fatalf("expected prior state to be preserved, got %s", newState.Value.GoString())
 	}
 }
+
+func TestNodeAbstractResourceInstance_apply_resultCache(t *testing.T) {
+	newNode := func(cache *applyResultCache) (*NodeAbstractResourceInstance, *MockEvalContext, *testing_provider.MockProvider) {
+		state := states.NewState()
+		evalCtx := &MockEvalContext{}
+		evalCtx.StateState = state.SyncWrapper()
+		evalCtx.Scope = evalContextModuleInstance{Addr: addrs.RootModuleInstance}
+
+		mockProvider := mockProviderWithResourceTypeSchema("aws_instance", &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"id": {
+					Type:     cty.String,
+					Optional: true,
+				},
+			},
+		})
+		mockProvider.ConfigureProviderCalled = true
+
+		node := &NodeAbstractResourceInstance{
+			Addr: mustResourceInstanceAddr("aws_instance.foo"),
+			NodeAbstractResource: NodeAbstractResource{
+				ResolvedProvider: mustProviderConfig(`provider["registry.terraform.io/hashicorp/aws"]`),
+			},
+			applyResultCache: cache,
+		}
+		evalCtx.ProviderProvider = mockProvider
+		evalCtx.ProviderSchemaSchema = mockProvider.GetProviderSchema()
+		return node, evalCtx, mockProvider
+	}
+
+	change := &plans.ResourceInstanceChange{
+		Addr: mustResourceInstanceAddr("aws_instance.foo"),
+		Change: plans.Change{
+			Action: plans.Update,
+			Before: cty.ObjectVal(map[string]cty.Value{
+				"id": cty.StringVal("prior"),
+			}),
+			After: cty.ObjectVal(map[string]cty.Value{
+				"id": cty.StringVal("new"),
+			}),
+		},
+	}
+	priorState := &states.ResourceInstanceObject{
+		Value:  change.Before,
+		Status: states.ObjectReady,
+	}
+	applyConfig := &configs.Resource{}
+	keyData := instances.RepetitionData{}
+
+	t.Run("a cache miss calls the provider and populates the cache", func(t *testing.T) {
+		cache := newApplyResultCache()
+		node, evalCtx, mockProvider := newNode(cache)
+		mockProvider.ApplyResourceChangeFn = func(providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+			return providers.ApplyResourceChangeResponse{
+				NewState: change.After,
+			}
+		}
+
+		newState, diags := node.apply(evalCtx, priorState, change, applyConfig, keyData, false)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+		if !mockProvider.ApplyResourceChangeCalled {
+			t.Fatal("expected the provider to be called on a cache miss")
+		}
+		if !newState.Value.RawEquals(change.After) {
+			t.Fatalf("wrong result: %s", newState.Value.GoString())
+		}
+		if _, ok := cache.get(node.Addr, change); !ok {
+			t.Fatal("expected the result to be cached after a successful apply")
+		}
+	})
+
+	t.Run("a cache hit skips the provider call", func(t *testing.T) {
+		cache := newApplyResultCache()
+		cache.put(mustResourceInstanceAddr("aws_instance.foo"), change, providers.ApplyResourceChangeResponse{
+			NewState: change.After,
+		})
+		node, evalCtx, mockProvider := newNode(cache)
+		mockProvider.ApplyResourceChangeFn = func(providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+			t.Fatal("the provider should not be called on a cache hit")
+			return providers.ApplyResourceChangeResponse{}
+		}
+
+		newState, diags := node.apply(evalCtx, priorState, change, applyConfig, keyData, false)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+		if mockProvider.ApplyResourceChangeCalled {
+			t.Fatal("expected the provider not to be called on a cache hit")
+		}
+		if !newState.Value.RawEquals(change.After) {
+			t.Fatalf("wrong result: %s", newState.Value.GoString())
+		}
+	})
+
+	t.Run("a failed apply is not cached", func(t *testing.T) {
+		cache := newApplyResultCache()
+		node, evalCtx, mockProvider := newNode(cache)
+		mockProvider.ApplyResourceChangeFn = func(providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+			return providers.ApplyResourceChangeResponse{
+				NewState: change.Before,
+				Diagnostics: tfdiags.Diagnostics{}.Append(
+					fmt.Errorf("something went wrong"),
+				),
+			}
+		}
+
+		_, diags := node.apply(evalCtx, priorState, change, applyConfig, keyData, false)
+		if !diags.HasErrors() {
+			t.Fatal("expected an error")
+		}
+		if _, ok := cache.get(node.Addr, change); ok {
+			t.Fatal("expected a failed apply not to be cached")
+		}
+	})
+
+	t.Run("no cache set means the provider is always called", func(t *testing.T) {
+		node, evalCtx, mockProvider := newNode(nil)
+		mockProvider.ApplyResourceChangeFn = func(providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+			return providers.ApplyResourceChangeResponse{
+				NewState: change.After,
+			}
+		}
+
+		_, diags := node.apply(evalCtx, priorState, change, applyConfig, keyData, false)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+		if !mockProvider.ApplyResourceChangeCalled {
+			t.Fatal("expected the provider to be called when no cache is configured")
+		}
+	})
+}
+
+func TestNodeAbstractResourceInstance_apply_timeout(t *testing.T) {
+	state := states.NewState()
+	evalCtx := &MockEvalContext{}
+	evalCtx.StateState = state.SyncWrapper()
+	evalCtx.Scope = evalContextModuleInstance{Addr: addrs.RootModuleInstance}
+
+	mockProvider := mockProviderWithResourceTypeSchema("aws_instance", &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"id": {
+				Type:     cty.String,
+				Optional: true,
+			},
+		},
+	})
+	mockProvider.ConfigureProviderCalled = true
+	mockProvider.ApplyResourceChangeFn = func(providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+		time.Sleep(50 * time.Millisecond)
+		return providers.ApplyResourceChangeResponse{
+			NewState: cty.ObjectVal(map[string]cty.Value{
+				"id": cty.StringVal("new"),
+			}),
+		}
+	}
+	evalCtx.ProviderProvider = mockProvider
+	evalCtx.ProviderSchemaSchema = mockProvider.GetProviderSchema()
+
+	node := &NodeAbstractResourceInstance{
+		Addr: mustResourceInstanceAddr("aws_instance.foo"),
+		NodeAbstractResource: NodeAbstractResource{
+			ResolvedProvider: mustProviderConfig(`provider["registry.terraform.io/hashicorp/aws"]`),
+			Config: &configs.Resource{
+				Managed: &configs.ManagedResource{
+					ApplyTimeout: time.Millisecond,
+				},
+			},
+		},
+	}
+
+	change := &plans.ResourceInstanceChange{
+		Addr: node.Addr,
+		Change: plans.Change{
+			Action: plans.Update,
+			Before: cty.ObjectVal(map[string]cty.Value{
+				"id": cty.StringVal("prior"),
+			}),
+			After: cty.ObjectVal(map[string]cty.Value{
+				"id": cty.StringVal("new"),
+			}),
+		},
+	}
+	priorState := &states.ResourceInstanceObject{
+		Value:  change.Before,
+		Status: states.ObjectReady,
+	}
+	applyConfig := &configs.Resource{}
+	keyData := instances.RepetitionData{}
+
+	newState, diags := node.apply(evalCtx, priorState, change, applyConfig, keyData, false)
+	if !newState.Value.RawEquals(change.After) {
+		t.Fatalf("expected the provider's reported state to be preserved, got %s", newState.Value.GoString())
+	}
+
+	var foundWarning bool
+	for _, diag := range diags {
+		if diag.Severity() == tfdiags.Warning && diag.Description().Summary == "Resource apply exceeded expected timeout" {
+			foundWarning = true
+		}
+	}
+	if !foundWarning {
+		t.Fatalf("expected a timeout warning diagnostic when apply_timeout is exceeded, got %#v", diags)
+	}
+}
+
+func TestNodeAbstractResourceInstance_apply_requireApplyConfirmation(t *testing.T) {
+	newNode := func(confirmer *fakeConfirmingProvider) (*NodeAbstractResourceInstance, *MockEvalContext) {
+		state := states.NewState()
+		evalCtx := &MockEvalContext{}
+		evalCtx.StateState = state.SyncWrapper()
+		evalCtx.Scope = evalContextModuleInstance{Addr: addrs.RootModuleInstance}
+		evalCtx.ProviderProvider = confirmer
+		evalCtx.ProviderSchemaSchema = confirmer.GetProviderSchema()
+
+		node := &NodeAbstractResourceInstance{
+			Addr: mustResourceInstanceAddr("aws_instance.foo"),
+			NodeAbstractResource: NodeAbstractResource{
+				ResolvedProvider: mustProviderConfig(`provider["registry.terraform.io/hashicorp/aws"]`),
+				Config: &configs.Resource{
+					Managed: &configs.ManagedResource{
+						RequireApplyConfirmation: true,
+					},
+				},
+			},
+		}
+		return node, evalCtx
+	}
+
+	change := &plans.ResourceInstanceChange{
+		Addr: mustResourceInstanceAddr("aws_instance.foo"),
+		Change: plans.Change{
+			Action: plans.Update,
+			Before: cty.ObjectVal(map[string]cty.Value{
+				"id": cty.StringVal("prior"),
+			}),
+			After: cty.ObjectVal(map[string]cty.Value{
+				"id": cty.StringVal("new"),
+			}),
+		},
+	}
+	priorState := &states.ResourceInstanceObject{
+		Value:  change.Before,
+		Status: states.ObjectReady,
+	}
+	applyConfig := &configs.Resource{}
+	keyData := instances.RepetitionData{}
+
+	t.Run("a refused confirmation blocks the apply", func(t *testing.T) {
+		confirmer := &fakeConfirmingProvider{
+			MockProvider: mockProviderWithResourceTypeSchema("aws_instance", &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"id": {Type: cty.String, Optional: true},
+				},
+			}),
+			refuse: true,
+		}
+		confirmer.ConfigureProviderCalled = true
+		confirmer.ApplyResourceChangeFn = func(providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+			t.Fatal("the provider should not be applied after a refused confirmation")
+			return providers.ApplyResourceChangeResponse{}
+		}
+		node, evalCtx := newNode(confirmer)
+
+		_, diags := node.apply(evalCtx, priorState, change, applyConfig, keyData, false)
+		if !diags.HasErrors() {
+			t.Fatal("expected errors from a refused confirmation")
+		}
+		if confirmer.confirmCalls != 1 {
+			t.Fatalf("expected exactly one ConfirmApply call, got %d", confirmer.confirmCalls)
+		}
+		if confirmer.ApplyResourceChangeCalled {
+			t.Fatal("expected the provider not to be applied after a refused confirmation")
+		}
+	})
+
+	t.Run("a confirmed apply proceeds to the provider", func(t *testing.T) {
+		confirmer := &fakeConfirmingProvider{
+			MockProvider: mockProviderWithResourceTypeSchema("aws_instance", &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"id": {Type: cty.String, Optional: true},
+				},
+			}),
+		}
+		confirmer.ConfigureProviderCalled = true
+		confirmer.ApplyResourceChangeFn = func(providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+			return providers.ApplyResourceChangeResponse{
+				NewState: change.After,
+			}
+		}
+		node, evalCtx := newNode(confirmer)
+
+		newState, diags := node.apply(evalCtx, priorState, change, applyConfig, keyData, false)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+		if confirmer.confirmCalls != 1 {
+			t.Fatalf("expected exactly one ConfirmApply call, got %d", confirmer.confirmCalls)
+		}
+		if !newState.Value.RawEquals(change.After) {
+			t.Fatalf("wrong result: %s", newState.Value.GoString())
+		}
+	})
+}
+
+func TestNodeAbstractResourceInstance_apply_useProviderTransaction(t *testing.T) {
+	change := &plans.ResourceInstanceChange{
+		Addr: mustResourceInstanceAddr("aws_instance.foo"),
+		Change: plans.Change{
+			Action: plans.Update,
+			Before: cty.ObjectVal(map[string]cty.Value{
+				"id": cty.StringVal("prior"),
+			}),
+			After: cty.ObjectVal(map[string]cty.Value{
+				"id": cty.StringVal("new"),
+			}),
+		},
+	}
+	priorState := &states.ResourceInstanceObject{
+		Value:  change.Before,
+		Status: states.ObjectReady,
+	}
+	applyConfig := &configs.Resource{}
+	keyData := instances.RepetitionData{}
+
+	newNode := func(provider *fakeTransactionalProvider) (*NodeAbstractResourceInstance, *MockEvalContext) {
+		state := states.NewState()
+		evalCtx := &MockEvalContext{}
+		evalCtx.StateState = state.SyncWrapper()
+		evalCtx.Scope = evalContextModuleInstance{Addr: addrs.RootModuleInstance}
+		evalCtx.ProviderProvider = provider
+		evalCtx.ProviderSchemaSchema = provider.GetProviderSchema()
+
+		node := &NodeAbstractResourceInstance{
+			Addr: mustResourceInstanceAddr("aws_instance.foo"),
+			NodeAbstractResource: NodeAbstractResource{
+				ResolvedProvider: mustProviderConfig(`provider["registry.terraform.io/hashicorp/aws"]`),
+				Config: &configs.Resource{
+					Managed: &configs.ManagedResource{
+						UseProviderTransaction: true,
+					},
+				},
+			},
+		}
+		return node, evalCtx
+	}
+
+	t.Run("a successful apply commits the transaction", func(t *testing.T) {
+		provider := &fakeTransactionalProvider{
+			MockProvider: mockProviderWithResourceTypeSchema("aws_instance", &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"id": {Type: cty.String, Optional: true},
+				},
+			}),
+		}
+		provider.ConfigureProviderCalled = true
+		provider.ApplyResourceChangeFn = func(providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+			return providers.ApplyResourceChangeResponse{
+				NewState: change.After,
+			}
+		}
+		node, evalCtx := newNode(provider)
+
+		newState, diags := node.apply(evalCtx, priorState, change, applyConfig, keyData, false)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+		if provider.began != 1 || provider.committed != 1 || provider.rolledBack != 0 {
+			t.Fatalf("wrong transaction calls: began=%d committed=%d rolledBack=%d", provider.began, provider.committed, provider.rolledBack)
+		}
+		if !newState.Value.RawEquals(change.After) {
+			t.Fatalf("wrong result: %s", newState.Value.GoString())
+		}
+	})
+
+	t.Run("a failed apply rolls back the transaction", func(t *testing.T) {
+		provider := &fakeTransactionalProvider{
+			MockProvider: mockProviderWithResourceTypeSchema("aws_instance", &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"id": {Type: cty.String, Optional: true},
+				},
+			}),
+		}
+		provider.ConfigureProviderCalled = true
+		provider.ApplyResourceChangeFn = func(providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+			return providers.ApplyResourceChangeResponse{
+				NewState:    change.Before,
+				Diagnostics: tfdiags.Diagnostics{}.Append(fmt.Errorf("something went wrong")),
+			}
+		}
+		node, evalCtx := newNode(provider)
+
+		_, diags := node.apply(evalCtx, priorState, change, applyConfig, keyData, false)
+		if !diags.HasErrors() {
+			t.Fatal("expected errors")
+		}
+		if provider.began != 1 || provider.committed != 0 || provider.rolledBack != 1 {
+			t.Fatalf("wrong transaction calls: began=%d committed=%d rolledBack=%d", provider.began, provider.committed, provider.rolledBack)
+		}
+	})
+}
+
+func TestNodeAbstractResourceInstance_preDestructiveApplySnapshot(t *testing.T) {
+	node := &NodeAbstractResourceInstance{
+		NodeAbstractResource: NodeAbstractResource{
+			Addr: addrs.ConfigResource{
+				Resource: addrs.Resource{
+					Mode: addrs.ManagedResourceMode,
+					Type: "aws_instance",
+					Name: "foo",
+				},
+			},
+		},
+	}
+
+	existing := &states.ResourceInstanceObject{
+		Value: cty.ObjectVal(map[string]cty.Value{
+			"id": cty.StringVal("existing"),
+		}),
+		Status:  states.ObjectReady,
+		Private: []byte("private data"),
+	}
+
+	t.Run("delete", func(t *testing.T) {
+		snapshot := node.preDestructiveApplySnapshot(existing, plans.Delete)
+		if snapshot == nil {
+			t.Fatal("expected a snapshot, got nil")
+		}
+		if !snapshot.Value.RawEquals(existing.Value) {
+			t.Fatalf("snapshot value doesn't match the original: %s", snapshot.Value.GoString())
+		}
+		if snapshot == existing {
+			t.Fatal("expected an independent copy, got the same object")
+		}
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		for _, action := range []plans.Action{plans.CreateThenDelete, plans.DeleteThenCreate} {
+			snapshot := node.preDestructiveApplySnapshot(existing, action)
+			if snapshot == nil {
+				t.Fatalf("expected a snapshot for action %s, got nil", action)
+			}
+		}
+	})
+
+	t.Run("survives mutation of the original", func(t *testing.T) {
+		mutable := existing.DeepCopy()
+		snapshot := node.preDestructiveApplySnapshot(mutable, plans.Delete)
+		mutable.Value = cty.ObjectVal(map[string]cty.Value{
+			"id": cty.StringVal("mutated-after-snapshot"),
+		})
+		if !snapshot.Value.RawEquals(existing.Value) {
+			t.Fatalf("snapshot was affected by mutating the original state: %s", snapshot.Value.GoString())
+		}
+	})
+
+	t.Run("no-op for non-destructive actions", func(t *testing.T) {
+		for _, action := range []plans.Action{plans.NoOp, plans.Create, plans.Update, plans.Read} {
+			if snapshot := node.preDestructiveApplySnapshot(existing, action); snapshot != nil {
+				t.Fatalf("expected no snapshot for action %s, got one", action)
+			}
+		}
+	})
+
+	t.Run("nil state has nothing to snapshot", func(t *testing.T) {
+		if snapshot := node.preDestructiveApplySnapshot(nil, plans.Delete); snapshot != nil {
+			t.Fatal("expected no snapshot for a nil state")
+		}
+	})
+
+	t.Run("null value has nothing to snapshot", func(t *testing.T) {
+		null := &states.ResourceInstanceObject{
+			Value:  cty.NullVal(cty.EmptyObject),
+			Status: states.ObjectReady,
+		}
+		if snapshot := node.preDestructiveApplySnapshot(null, plans.Delete); snapshot != nil {
+			t.Fatal("expected no snapshot for an object that doesn't exist yet")
+		}
+	})
+}
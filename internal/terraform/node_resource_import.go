@@ -93,11 +93,16 @@ func (n *graphNodeImportState) Execute(ctx EvalContext, op walkOperation) (diags
 		return diags
 	}
 
-	resp := provider.ImportResourceState(providers.ImportResourceStateRequest{
+	diags = diags.Append(validateImportIDFormat(n.ID, schema, nil))
+	if diags.HasErrors() {
+		return diags
+	}
+
+	resp := importResourceStateWithRetry(provider, providers.ImportResourceStateRequest{
 		TypeName:           n.Addr.Resource.Resource.Type,
 		ID:                 n.ID,
 		ClientCapabilities: ctx.ClientCapabilities(),
-	})
+	}, defaultImportRetryAttempts)
 	diags = diags.Append(resp.Diagnostics)
 	if diags.HasErrors() {
 		return diags
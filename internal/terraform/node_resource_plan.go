@@ -163,7 +163,11 @@ func (n *nodeExpandPlannableResource) expandResourceImports(ctx EvalContext, all
 			// if we have a legacy addr, it was supplied on the commandline so
 			// there is nothing to expand
 			if !imp.LegacyAddr.Equal(addrs.AbsResourceInstance{}) {
-				knownImports.Put(imp.LegacyAddr, cty.StringVal(imp.LegacyID))
+				if imp.LegacyIdentity != cty.NilVal {
+					knownImports.Put(imp.LegacyAddr, imp.LegacyIdentity)
+				} else {
+					knownImports.Put(imp.LegacyAddr, cty.StringVal(imp.LegacyID))
+				}
 				return knownImports, unknownImports, diags
 			}
 
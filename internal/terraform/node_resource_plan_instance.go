@@ -706,18 +706,25 @@ func (n *NodePlannableResourceInstance) importState(ctx EvalContext, addr addrs.
 	} else {
 		if importTarget.Type().IsObjectType() {
 			// Identity-based import
-			resp = provider.ImportResourceState(providers.ImportResourceStateRequest{
+			resp = importResourceStateWithRetry(provider, providers.ImportResourceStateRequest{
 				TypeName:           addr.Resource.Resource.Type,
 				Identity:           importTarget,
 				ClientCapabilities: ctx.ClientCapabilities(),
-			})
+			}, defaultImportRetryAttempts)
 		} else {
 			// ID-based/string import
-			resp = provider.ImportResourceState(providers.ImportResourceStateRequest{
+			if importTarget.IsKnown() {
+				diags = diags.Append(validateImportIDFormat(importTarget.AsString(), schema, nil))
+				if diags.HasErrors() {
+					return nil, deferred, diags
+				}
+			}
+
+			resp = importResourceStateWithRetry(provider, providers.ImportResourceStateRequest{
 				TypeName:           addr.Resource.Resource.Type,
 				ID:                 importTarget.AsString(),
 				ClientCapabilities: ctx.ClientCapabilities(),
-			})
+			}, defaultImportRetryAttempts)
 		}
 	}
 	// If we don't support deferrals, but the provider reports a deferral and does not
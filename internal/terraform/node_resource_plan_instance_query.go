@@ -82,6 +82,12 @@ func (n *NodePlannableResourceInstance) listResourceExecute(ctx EvalContext) (di
 		return diags
 	}
 
+	_, sortBy, sortByDiags := newSortByEvaluator(false).EvaluateExpr(ctx, config.List.SortBy)
+	diags = diags.Append(sortByDiags)
+	if sortByDiags.HasErrors() {
+		return diags
+	}
+
 	if config.List.IncludeResource != nil {
 		var includeDeprecationDiags tfdiags.Diagnostics
 		includeRscCty, includeDeprecationDiags = ctx.Deprecations().ValidateAndUnmark(includeRscCty, ctx.Path().Module(), config.List.IncludeResource.Range().Ptr())
@@ -135,6 +141,22 @@ func (n *NodePlannableResourceInstance) listResourceExecute(ctx EvalContext) (di
 	if diags.HasErrors() {
 		return diags
 	}
+
+	if sortBy != "" && !resp.Result.IsNull() && resp.Result.Type().HasAttribute("data") {
+		sorted, sortErr := sortAndLimitListResults(resp.Result.GetAttr("data"), sortBy, 0)
+		if sortErr != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid sort_by argument",
+				fmt.Sprintf("Could not sort the results of %s by %q: %s.", n.Addr, sortBy, sortErr),
+			))
+			return diags
+		}
+		resultAttrs := resp.Result.AsValueMap()
+		resultAttrs["data"] = sorted
+		resp.Result = cty.ObjectVal(resultAttrs)
+	}
+
 	results := plans.QueryResults{
 		Value: resp.Result,
 	}
@@ -229,6 +229,8 @@ func (n *NodeValidatableResource) evaluateBlock(ctx EvalContext, body hcl.Body,
 
 	val, hclBody, diags := ctx.EvaluateBlock(body, schema, selfAddr, keyData)
 
+	diags = diags.Append(schema.ValidateNoComputedOnlyBlocks(body))
+
 	var deprecationDiags tfdiags.Diagnostics
 	val, deprecationDiags = ctx.Deprecations().ValidateAndUnmarkConfig(val, schema, n.Addr.Module)
 	diags = diags.Append(deprecationDiags.InConfigBody(body, n.Addr.String()))
@@ -632,6 +634,14 @@ func (n *NodeValidatableResource) validateResource(ctx EvalContext) tfdiags.Diag
 			diags = diags.Append(deprecationDiags)
 		}
 
+		if n.Config.List.SortBy != nil {
+			_, _, sortByDiags := newSortByEvaluator(true).EvaluateExpr(ctx, n.Config.List.SortBy)
+			diags = diags.Append(sortByDiags)
+			if sortByDiags.HasErrors() {
+				return diags
+			}
+		}
+
 		// Use unmarked value for validate request
 		unmarkedBlockVal, _ := blockVal.UnmarkDeep()
 
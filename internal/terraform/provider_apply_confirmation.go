@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// confirmApplyIfRequired gives a provider that implements
+// providers.ApplyConfirmer a chance to refuse an apply before it happens,
+// for resource instances that are flagged as requiring confirmation.
+//
+// flagged should be true only for resource instances that configuration or
+// policy has specifically marked as high-risk; applies for everything else
+// proceed without ever calling ConfirmApply, even for providers that
+// implement it. Providers that don't implement providers.ApplyConfirmer are
+// always allowed to proceed.
+func confirmApplyIfRequired(provider providers.Interface, flagged bool, typeName string, priorState, plannedState cty.Value) tfdiags.Diagnostics {
+	if !flagged {
+		return nil
+	}
+
+	confirmer, ok := provider.(providers.ApplyConfirmer)
+	if !ok {
+		return nil
+	}
+
+	resp := confirmer.ConfirmApply(providers.ConfirmApplyRequest{
+		TypeName:     typeName,
+		PriorState:   priorState,
+		PlannedState: plannedState,
+	})
+	return resp.Diagnostics
+}
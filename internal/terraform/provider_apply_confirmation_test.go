@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/providers"
+	testing_provider "github.com/hashicorp/terraform/internal/providers/testing"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// fakeConfirmingProvider is a minimal providers.ApplyConfirmer
+// implementation, built on top of the shared mock provider, for testing
+// apply confirmation gating without a real backend.
+type fakeConfirmingProvider struct {
+	*testing_provider.MockProvider
+
+	confirmCalls int
+	refuse       bool
+}
+
+func (p *fakeConfirmingProvider) ConfirmApply(providers.ConfirmApplyRequest) providers.ConfirmApplyResponse {
+	p.confirmCalls++
+	if p.refuse {
+		var diags tfdiags.Diagnostics
+		diags = diags.Append(tfdiags.Sourceless(tfdiags.Error, "apply refused", "provider refused the apply"))
+		return providers.ConfirmApplyResponse{Diagnostics: diags}
+	}
+	return providers.ConfirmApplyResponse{}
+}
+
+func TestConfirmApplyIfRequired_notFlagged(t *testing.T) {
+	p := &fakeConfirmingProvider{MockProvider: &testing_provider.MockProvider{}}
+
+	diags := confirmApplyIfRequired(p, false, "test_thing", cty.NilVal, cty.NilVal)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if p.confirmCalls != 0 {
+		t.Fatalf("ConfirmApply should not be called for unflagged resources, got %d calls", p.confirmCalls)
+	}
+}
+
+func TestConfirmApplyIfRequired_confirmed(t *testing.T) {
+	p := &fakeConfirmingProvider{MockProvider: &testing_provider.MockProvider{}}
+
+	diags := confirmApplyIfRequired(p, true, "test_thing", cty.NilVal, cty.NilVal)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if p.confirmCalls != 1 {
+		t.Fatalf("expected exactly one ConfirmApply call, got %d", p.confirmCalls)
+	}
+}
+
+func TestConfirmApplyIfRequired_refused(t *testing.T) {
+	p := &fakeConfirmingProvider{MockProvider: &testing_provider.MockProvider{}, refuse: true}
+
+	diags := confirmApplyIfRequired(p, true, "test_thing", cty.NilVal, cty.NilVal)
+	if !diags.HasErrors() {
+		t.Fatal("expected errors from a refused confirmation, got none")
+	}
+}
+
+func TestConfirmApplyIfRequired_nonConfirmingProvider(t *testing.T) {
+	p := &testing_provider.MockProvider{}
+
+	diags := confirmApplyIfRequired(p, true, "test_thing", cty.NilVal, cty.NilVal)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/states"
+)
+
+// ProviderConfigChangeImpact describes which resources a pending change to a
+// provider configuration would affect, grouped by the kind of impact.
+//
+// An address never appears in more than one of these slices: a resource
+// that would be deferred because the new provider configuration is unknown
+// is reported only in Deferred, not also in ReRead or RePlanned.
+type ProviderConfigChangeImpact struct {
+	// RePlanned lists the managed resources, currently associated with the
+	// provider configuration being changed, that would need to be
+	// re-planned against the new configuration.
+	RePlanned []addrs.AbsResource
+
+	// ReRead lists the data resources, currently associated with the
+	// provider configuration being changed, that would need to be re-read
+	// against the new configuration.
+	ReRead []addrs.AbsResource
+
+	// Deferred lists the resources, of either mode, that couldn't be
+	// re-planned or re-read immediately because the new provider
+	// configuration isn't yet known, and so would instead be handled by
+	// the unknownProvider stub.
+	Deferred []addrs.AbsResource
+}
+
+// AnalyzeProviderConfigChangeImpact reports which resources currently
+// recorded against provider in state would be affected by a change to that
+// provider's configuration.
+//
+// configUnknown should be true when the new provider configuration is not
+// yet fully known, such as because it depends on a value that won't be
+// resolved until apply time. In that case every affected resource is
+// reported as deferred, mirroring how Terraform Core would actually handle
+// planning against an unknown provider configuration by substituting the
+// unknownProvider stub rather than re-planning or re-reading the resource
+// for real.
+func AnalyzeProviderConfigChangeImpact(state *states.State, provider addrs.AbsProviderConfig, configUnknown bool) ProviderConfigChangeImpact {
+	var impact ProviderConfigChangeImpact
+	if state == nil {
+		return impact
+	}
+
+	for _, ms := range state.Modules {
+		for _, rs := range ms.Resources {
+			if !rs.ProviderConfig.Equal(provider) {
+				continue
+			}
+
+			switch {
+			case configUnknown:
+				impact.Deferred = append(impact.Deferred, rs.Addr)
+			case rs.Addr.Resource.Mode == addrs.DataResourceMode:
+				impact.ReRead = append(impact.ReRead, rs.Addr)
+			default:
+				impact.RePlanned = append(impact.RePlanned, rs.Addr)
+			}
+		}
+	}
+
+	sortAbsResources(impact.RePlanned)
+	sortAbsResources(impact.ReRead)
+	sortAbsResources(impact.Deferred)
+
+	return impact
+}
+
+func sortAbsResources(addrs []addrs.AbsResource) {
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].String() < addrs[j].String()
+	})
+}
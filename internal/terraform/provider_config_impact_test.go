@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/states"
+)
+
+func providerConfigImpactFixture() (*states.State, addrs.AbsProviderConfig) {
+	provider := addrs.AbsProviderConfig{
+		Provider: addrs.NewDefaultProvider("test"),
+		Module:   addrs.RootModule,
+	}
+	otherProvider := addrs.AbsProviderConfig{
+		Provider: addrs.NewDefaultProvider("other"),
+		Module:   addrs.RootModule,
+	}
+
+	state := states.NewState()
+	root := state.RootModule()
+
+	root.SetResourceInstanceCurrent(
+		addrs.Resource{Mode: addrs.ManagedResourceMode, Type: "test_thing", Name: "managed"}.Instance(addrs.NoKey),
+		&states.ResourceInstanceObjectSrc{Status: states.ObjectReady, AttrsJSON: []byte(`{}`)},
+		provider,
+	)
+	root.SetResourceInstanceCurrent(
+		addrs.Resource{Mode: addrs.DataResourceMode, Type: "test_thing", Name: "data"}.Instance(addrs.NoKey),
+		&states.ResourceInstanceObjectSrc{Status: states.ObjectReady, AttrsJSON: []byte(`{}`)},
+		provider,
+	)
+	root.SetResourceInstanceCurrent(
+		addrs.Resource{Mode: addrs.ManagedResourceMode, Type: "test_thing", Name: "unrelated"}.Instance(addrs.NoKey),
+		&states.ResourceInstanceObjectSrc{Status: states.ObjectReady, AttrsJSON: []byte(`{}`)},
+		otherProvider,
+	)
+
+	return state, provider
+}
+
+func TestAnalyzeProviderConfigChangeImpact(t *testing.T) {
+	t.Run("splits managed and data resources when the new config is known", func(t *testing.T) {
+		state, provider := providerConfigImpactFixture()
+
+		impact := AnalyzeProviderConfigChangeImpact(state, provider, false)
+
+		if got, want := len(impact.RePlanned), 1; got != want {
+			t.Fatalf("wrong number of re-planned resources: got %d, want %d", got, want)
+		}
+		if got, want := impact.RePlanned[0].String(), "test_thing.managed"; got != want {
+			t.Fatalf("wrong re-planned resource: got %s, want %s", got, want)
+		}
+		if got, want := len(impact.ReRead), 1; got != want {
+			t.Fatalf("wrong number of re-read resources: got %d, want %d", got, want)
+		}
+		if got, want := impact.ReRead[0].String(), "data.test_thing.data"; got != want {
+			t.Fatalf("wrong re-read resource: got %s, want %s", got, want)
+		}
+		if len(impact.Deferred) != 0 {
+			t.Fatalf("expected no deferred resources, got %#v", impact.Deferred)
+		}
+	})
+
+	t.Run("defers everything when the new config is unknown", func(t *testing.T) {
+		state, provider := providerConfigImpactFixture()
+
+		impact := AnalyzeProviderConfigChangeImpact(state, provider, true)
+
+		if len(impact.RePlanned) != 0 {
+			t.Fatalf("expected no re-planned resources, got %#v", impact.RePlanned)
+		}
+		if len(impact.ReRead) != 0 {
+			t.Fatalf("expected no re-read resources, got %#v", impact.ReRead)
+		}
+		if got, want := len(impact.Deferred), 2; got != want {
+			t.Fatalf("wrong number of deferred resources: got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("ignores resources belonging to other provider configs", func(t *testing.T) {
+		state, provider := providerConfigImpactFixture()
+
+		impact := AnalyzeProviderConfigChangeImpact(state, provider, false)
+
+		for _, addr := range append(append([]addrs.AbsResource{}, impact.RePlanned...), impact.ReRead...) {
+			if addr.String() == "test_thing.unrelated" {
+				t.Fatalf("unrelated resource %s should not be part of the impact set", addr)
+			}
+		}
+	})
+
+	t.Run("nil state has no impact", func(t *testing.T) {
+		provider := addrs.AbsProviderConfig{
+			Provider: addrs.NewDefaultProvider("test"),
+			Module:   addrs.RootModule,
+		}
+		impact := AnalyzeProviderConfigChangeImpact(nil, provider, false)
+		if len(impact.RePlanned) != 0 || len(impact.ReRead) != 0 || len(impact.Deferred) != 0 {
+			t.Fatalf("expected an empty impact, got %#v", impact)
+		}
+	})
+}
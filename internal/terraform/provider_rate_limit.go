@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+)
+
+// graphNodeProviderApplyRateLimiterConsumer is implemented by graph nodes
+// that can consult a shared ProviderApplyRateLimiter during apply, if the
+// running operation requested one via ContextOpts.ProviderApplyRateLimits.
+type graphNodeProviderApplyRateLimiterConsumer interface {
+	SetProviderApplyRateLimiter(limiter *ProviderApplyRateLimiter)
+}
+
+// ProviderApplyRateLimiter spaces out apply operations against a provider so
+// that they don't arrive in bursts large enough to trigger the provider's
+// own rate limiting.
+//
+// Declared provider rate limits aren't something any provider can currently
+// report through providers.Interface, so this is only ever populated from
+// ContextOpts.ProviderApplyRateLimits, which a caller sets from whatever
+// source it has for those limits (for example, a CLI flag or a provider
+// requirements file). A node only consults this if one has been explicitly
+// attached to it; by default there is none, and applies proceed unthrottled.
+type ProviderApplyRateLimiter struct {
+	mu sync.Mutex
+
+	// minInterval is the minimum spacing enforced between two applies
+	// against the same provider. A provider with no entry here is
+	// unrestricted, including the unknownProvider stub paths, which never
+	// reach real apply and so are never rate limited.
+	minInterval map[addrs.Provider]time.Duration
+	last        map[addrs.Provider]time.Time
+}
+
+// NewProviderApplyRateLimiter returns a rate limiter that enforces the given
+// applies-per-second limit for each provider present in limits. Providers
+// with no entry in limits are never delayed.
+func NewProviderApplyRateLimiter(limits map[addrs.Provider]float64) *ProviderApplyRateLimiter {
+	minInterval := make(map[addrs.Provider]time.Duration, len(limits))
+	for provider, perSecond := range limits {
+		if perSecond <= 0 {
+			continue
+		}
+		minInterval[provider] = time.Duration(float64(time.Second) / perSecond)
+	}
+	return &ProviderApplyRateLimiter{
+		minInterval: minInterval,
+		last:        make(map[addrs.Provider]time.Time),
+	}
+}
+
+// Wait blocks until it's been at least the provider's configured minimum
+// interval since the last call to Wait for that same provider, or until ctx
+// is cancelled, whichever comes first.
+func (l *ProviderApplyRateLimiter) Wait(ctx context.Context, provider addrs.Provider) error {
+	interval, limited := l.minInterval[provider]
+	if !limited {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	wait := interval - now.Sub(l.last[provider])
+	if wait < 0 {
+		wait = 0
+	}
+	l.last[provider] = now.Add(wait)
+	l.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
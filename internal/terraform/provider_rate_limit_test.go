@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+)
+
+func TestProviderApplyRateLimiterSpacesApplies(t *testing.T) {
+	provider := addrs.NewDefaultProvider("limited")
+	const perSecond = 50 // 20ms minimum spacing
+	minInterval := time.Second / perSecond
+
+	limiter := NewProviderApplyRateLimiter(map[addrs.Provider]float64{
+		provider: perSecond,
+	})
+
+	ctx := context.Background()
+
+	// The first call should never be delayed, since there's no prior apply
+	// to space away from.
+	start := time.Now()
+	if err := limiter.Wait(ctx, provider); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if elapsed := time.Since(start); elapsed > minInterval {
+		t.Fatalf("first call was unexpectedly delayed by %s", elapsed)
+	}
+
+	// A second call immediately afterwards should be held back until the
+	// minimum interval has elapsed.
+	start = time.Now()
+	if err := limiter.Wait(ctx, provider); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if elapsed := time.Since(start); elapsed < minInterval {
+		t.Fatalf("second call was not spaced out: waited only %s, want at least %s", elapsed, minInterval)
+	}
+}
+
+func TestProviderApplyRateLimiterUnlimitedProvider(t *testing.T) {
+	limiter := NewProviderApplyRateLimiter(map[addrs.Provider]float64{
+		addrs.NewDefaultProvider("limited"): 1,
+	})
+
+	unlimited := addrs.NewDefaultProvider("unlimited")
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		start := time.Now()
+		if err := limiter.Wait(ctx, unlimited); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if elapsed := time.Since(start); elapsed > 10*time.Millisecond {
+			t.Fatalf("call %d to an unlimited provider was unexpectedly delayed by %s", i, elapsed)
+		}
+	}
+}
+
+func TestProviderApplyRateLimiterContextCancellation(t *testing.T) {
+	provider := addrs.NewDefaultProvider("limited")
+	limiter := NewProviderApplyRateLimiter(map[addrs.Provider]float64{
+		provider: 1, // 1 second minimum spacing
+	})
+
+	ctx := context.Background()
+	if err := limiter.Wait(ctx, provider); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := limiter.Wait(cancelCtx, provider); err == nil {
+		t.Fatal("expected an error from a cancelled context, got none")
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import "github.com/zclconf/go-cty/cty"
+
+// providerConfigChanged reports whether newConfig represents a different
+// provider configuration than oldConfig, and therefore requires the
+// provider to be reconfigured rather than continuing to use whatever client
+// it already set up (for example, an SDK client holding a credential it
+// obtained the last time it was configured).
+//
+// This matters most for configuration values that carry credentials:
+// providers commonly cache an authenticated client keyed by nothing more
+// than "has Configure already been called", so a credential that rotates
+// between runs (or between two plans sharing a long-lived provider
+// instance) would otherwise go unnoticed.
+//
+// If newConfig is not wholly known, we can't yet tell whether it differs
+// from oldConfig, so providerConfigChanged returns false; the caller should
+// leave reconfiguration to whatever handles still-unknown provider
+// configuration, such as the unknownProvider stub used during partial
+// stack plans.
+func providerConfigChanged(oldConfig, newConfig cty.Value) bool {
+	if !newConfig.IsWhollyKnown() {
+		return false
+	}
+	return !oldConfig.RawEquals(newConfig)
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestProviderConfigChanged(t *testing.T) {
+	tests := map[string]struct {
+		old, new cty.Value
+		want     bool
+	}{
+		"unchanged": {
+			old:  cty.StringVal("secret-v1"),
+			new:  cty.StringVal("secret-v1"),
+			want: false,
+		},
+		"rotated secret": {
+			old:  cty.StringVal("secret-v1"),
+			new:  cty.StringVal("secret-v2"),
+			want: true,
+		},
+		"still unknown, defer to unknownProvider": {
+			old:  cty.StringVal("secret-v1"),
+			new:  cty.UnknownVal(cty.String),
+			want: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := providerConfigChanged(test.old, test.new)
+			if got != test.want {
+				t.Fatalf("wrong result\ngot:  %v\nwant: %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// applyWithProviderTransaction wraps a single apply operation in a
+// provider-level transaction when the given provider supports one, so that
+// the apply can be rolled back if it fails partway through. Providers that
+// don't implement providers.Transactional are unaffected: applyFn is simply
+// invoked directly.
+//
+// applyFn should perform the apply and report whether it succeeded. Its
+// diagnostics are always included in the result, regardless of whether a
+// transaction was used.
+func applyWithProviderTransaction(provider providers.Interface, applyFn func() (ok bool, diags tfdiags.Diagnostics)) tfdiags.Diagnostics {
+	txProvider, ok := provider.(providers.Transactional)
+	if !ok {
+		_, diags := applyFn()
+		return diags
+	}
+
+	var diags tfdiags.Diagnostics
+
+	beginResp := txProvider.BeginTransaction(providers.BeginTransactionRequest{})
+	diags = diags.Append(beginResp.Diagnostics)
+	if beginResp.Diagnostics.HasErrors() {
+		return diags
+	}
+
+	ok, applyDiags := applyFn()
+	diags = diags.Append(applyDiags)
+
+	if ok && !applyDiags.HasErrors() {
+		commitResp := txProvider.CommitTransaction(providers.CommitTransactionRequest{})
+		diags = diags.Append(commitResp.Diagnostics)
+		return diags
+	}
+
+	rollbackResp := txProvider.RollbackTransaction(providers.RollbackTransactionRequest{})
+	diags = diags.Append(rollbackResp.Diagnostics)
+	return diags
+}
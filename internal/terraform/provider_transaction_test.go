@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/providers"
+	testing_provider "github.com/hashicorp/terraform/internal/providers/testing"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// fakeTransactionalProvider is a minimal providers.Transactional
+// implementation, built on top of the shared mock provider, for testing
+// transaction bracketing without a real backend.
+type fakeTransactionalProvider struct {
+	*testing_provider.MockProvider
+
+	began, committed, rolledBack int
+}
+
+func (p *fakeTransactionalProvider) BeginTransaction(providers.BeginTransactionRequest) providers.BeginTransactionResponse {
+	p.began++
+	return providers.BeginTransactionResponse{}
+}
+
+func (p *fakeTransactionalProvider) CommitTransaction(providers.CommitTransactionRequest) providers.CommitTransactionResponse {
+	p.committed++
+	return providers.CommitTransactionResponse{}
+}
+
+func (p *fakeTransactionalProvider) RollbackTransaction(providers.RollbackTransactionRequest) providers.RollbackTransactionResponse {
+	p.rolledBack++
+	return providers.RollbackTransactionResponse{}
+}
+
+func TestApplyWithProviderTransaction_commit(t *testing.T) {
+	p := &fakeTransactionalProvider{MockProvider: &testing_provider.MockProvider{}}
+
+	diags := applyWithProviderTransaction(p, func() (bool, tfdiags.Diagnostics) {
+		return true, nil
+	})
+
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if p.began != 1 || p.committed != 1 || p.rolledBack != 0 {
+		t.Fatalf("wrong transaction calls: began=%d committed=%d rolledBack=%d", p.began, p.committed, p.rolledBack)
+	}
+}
+
+func TestApplyWithProviderTransaction_rollback(t *testing.T) {
+	p := &fakeTransactionalProvider{MockProvider: &testing_provider.MockProvider{}}
+
+	wantErr := tfdiags.Diagnostics(nil).Append(tfdiags.Sourceless(tfdiags.Error, "apply failed", "something went wrong"))
+
+	diags := applyWithProviderTransaction(p, func() (bool, tfdiags.Diagnostics) {
+		return false, wantErr
+	})
+
+	if !diags.HasErrors() {
+		t.Fatal("expected errors, got none")
+	}
+	if p.began != 1 || p.committed != 0 || p.rolledBack != 1 {
+		t.Fatalf("wrong transaction calls: began=%d committed=%d rolledBack=%d", p.began, p.committed, p.rolledBack)
+	}
+}
+
+func TestApplyWithProviderTransaction_nonTransactionalProvider(t *testing.T) {
+	p := &testing_provider.MockProvider{}
+
+	called := false
+	diags := applyWithProviderTransaction(p, func() (bool, tfdiags.Diagnostics) {
+		called = true
+		return true, nil
+	})
+
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if !called {
+		t.Fatal("applyFn was not called")
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/getproviders/providerreqs"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// CheckProviderVersionDowngrade compares the provider version that's about
+// to be used for a plan against the version that was locked by a previous
+// run, returning a warning diagnostic if the new version is older.
+//
+// Terraform's state format doesn't currently record which provider version
+// last touched a resource, so this can't compare against that. What it can
+// compare is the configured version against the version recorded in the
+// dependency lock file from a previous run, which is the closest
+// already-persisted record of "the version that last ran against this
+// configuration". Same-version and upgrade cases are silent; only a
+// downgrade produces a diagnostic, since schema versions are expected to
+// only move forward and a provider is not required to support downgrading
+// state written by a newer version of itself.
+func CheckProviderVersionDowngrade(provider addrs.Provider, configured, previouslyLocked providerreqs.Version) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	if configured.LessThan(previouslyLocked) {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Warning,
+			"Provider version downgrade",
+			fmt.Sprintf(
+				"The configuration for provider %s selected version %s, which is older than %s, the version locked by a previous run. Resources in state may have been written by the newer provider version and might not be readable by this older one.",
+				provider.ForDisplay(), configured, previouslyLocked,
+			),
+		))
+	}
+
+	return diags
+}
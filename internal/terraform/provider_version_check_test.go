@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/getproviders/providerreqs"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+func TestCheckProviderVersionDowngrade(t *testing.T) {
+	provider := addrs.NewDefaultProvider("test")
+
+	tests := map[string]struct {
+		configured, previouslyLocked string
+		wantWarning                  bool
+	}{
+		"upgrade": {
+			configured:       "2.0.0",
+			previouslyLocked: "1.0.0",
+			wantWarning:      false,
+		},
+		"same version": {
+			configured:       "1.2.3",
+			previouslyLocked: "1.2.3",
+			wantWarning:      false,
+		},
+		"downgrade": {
+			configured:       "1.0.0",
+			previouslyLocked: "1.2.3",
+			wantWarning:      true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			configured := providerreqs.MustParseVersion(test.configured)
+			previouslyLocked := providerreqs.MustParseVersion(test.previouslyLocked)
+
+			diags := CheckProviderVersionDowngrade(provider, configured, previouslyLocked)
+			if got := diags.HasErrors(); got {
+				t.Fatalf("unexpected errors: %s", diags.Err())
+			}
+
+			var gotWarning bool
+			for _, diag := range diags {
+				if diag.Severity() == tfdiags.Warning {
+					gotWarning = true
+				}
+			}
+			if gotWarning != test.wantWarning {
+				t.Fatalf("wrong result: got warning=%v, want warning=%v (%s)", gotWarning, test.wantWarning, diags.Err())
+			}
+		})
+	}
+}
@@ -466,6 +466,44 @@ func (t *PruneProviderTransformer) Transform(g *Graph) error {
 	return nil
 }
 
+// OrphanedProviderConfigTransformer reports a warning, via the returned
+// NonFatalError, for every provider configuration that PruneProviderTransformer
+// would silently remove for having no resources or data sources attached to
+// it. It must run before PruneProviderTransformer, since by the time pruning
+// happens the orphaned configurations are already gone from the graph.
+//
+// This is kept as its own optional transform, rather than folded into
+// PruneProviderTransformer itself, so that callers can opt into the warning
+// without it appearing in every graph walk that happens to prune a provider
+// for unrelated structural reasons (for example, a proxy provider in a
+// child module).
+type OrphanedProviderConfigTransformer struct{}
+
+func (t *OrphanedProviderConfigTransformer) Transform(g *Graph) error {
+	var diags tfdiags.Diagnostics
+
+	for _, v := range g.Vertices() {
+		provider, ok := v.(GraphNodeProvider)
+		if !ok {
+			continue
+		}
+		if _, isProxy := v.(*graphNodeProxyProvider); isProxy {
+			continue
+		}
+		if g.UpEdges(v).Len() > 0 {
+			continue
+		}
+
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Warning,
+			"Unused provider configuration",
+			fmt.Sprintf("Provider configuration %s is not used by any resource or data source, so it will not be configured.", provider.ProviderAddr()),
+		))
+	}
+
+	return diags.ErrWithWarnings()
+}
+
 func providerVertexMap(g *Graph) map[string]GraphNodeProvider {
 	m := make(map[string]GraphNodeProvider)
 	for _, v := range g.Vertices() {
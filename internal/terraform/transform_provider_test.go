@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/internal/addrs"
 	"github.com/hashicorp/terraform/internal/configs"
 	"github.com/hashicorp/terraform/internal/dag"
+	"github.com/hashicorp/terraform/internal/tfdiags"
 )
 
 func testProviderTransformerGraph(t *testing.T, cfg *configs.Config) *Graph {
@@ -237,6 +238,45 @@ func TestPruneProviderTransformer(t *testing.T) {
 	}
 }
 
+func TestOrphanedProviderConfigTransformer(t *testing.T) {
+	mod := testModule(t, "transform-provider-prune")
+
+	g := testProviderTransformerGraph(t, mod)
+	{
+		// The configured but unused aws provider only enters the graph
+		// through ProviderConfigTransformer.
+		transform := &ProviderConfigTransformer{
+			Config:   mod,
+			Concrete: func(a *NodeAbstractProvider) dag.Vertex { return a },
+		}
+		if err := transform.Transform(g); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+	{
+		transform := &MissingProviderTransformer{}
+		if err := transform.Transform(g); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+	{
+		transform := &ProviderTransformer{}
+		if err := transform.Transform(g); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	transform := &OrphanedProviderConfigTransformer{}
+	err := transform.Transform(g)
+	nf, isNF := err.(tfdiags.NonFatalError)
+	if !isNF {
+		t.Fatalf("expected a NonFatalError warning, got: %v", err)
+	}
+	if got, want := nf.Error(), `provider["registry.terraform.io/hashicorp/aws"] is not used`; !strings.Contains(got, want) {
+		t.Fatalf("wrong warning\ngot:  %s\nwant a message containing: %s", got, want)
+	}
+}
+
 // the child module resource is attached to the configured parent provider
 func TestProviderConfigTransformer_parentProviders(t *testing.T) {
 	mod := testModule(t, "transform-provider-inherit")
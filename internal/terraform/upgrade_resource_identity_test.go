@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/providers"
+	testing_provider "github.com/hashicorp/terraform/internal/providers/testing"
+	"github.com/hashicorp/terraform/internal/states"
+)
+
+func TestUpgradeResourceIdentity(t *testing.T) {
+	addr := mustResourceInstanceAddr("test_thing.foo")
+	identityType := cty.Object(map[string]cty.Type{"id": cty.String})
+
+	schema := providers.Schema{
+		Body: &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"id": {Type: cty.String, Computed: true},
+			},
+		},
+		IdentityVersion: 2,
+		Identity: &configschema.Object{
+			Attributes: map[string]*configschema.Attribute{
+				"id": {Type: cty.String, Required: true},
+			},
+			Nesting: configschema.NestingSingle,
+		},
+	}
+
+	encodeIdentity := func(v cty.Value) []byte {
+		raw, err := ctyjson.Marshal(v, v.Type())
+		if err != nil {
+			t.Fatalf("failed to encode identity: %s", err)
+		}
+		return raw
+	}
+
+	t.Run("no-op when the identity schema version already matches", func(t *testing.T) {
+		src := &states.ResourceInstanceObjectSrc{
+			IdentitySchemaVersion: 2,
+			IdentityJSON:          encodeIdentity(cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("existing")})),
+		}
+		provider := &testing_provider.MockProvider{ConfigureProviderCalled: true}
+
+		got, diags := upgradeResourceIdentity(addr, provider, src, schema)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+		if got != src {
+			t.Fatal("expected the original state object to be returned unchanged")
+		}
+		if provider.UpgradeResourceIdentityCalled {
+			t.Fatal("expected the provider not to be called when no upgrade is needed")
+		}
+	})
+
+	t.Run("errors when the state identity is newer than the current schema", func(t *testing.T) {
+		src := &states.ResourceInstanceObjectSrc{
+			IdentitySchemaVersion: 3,
+			IdentityJSON:          encodeIdentity(cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("existing")})),
+		}
+		provider := &testing_provider.MockProvider{ConfigureProviderCalled: true}
+
+		_, diags := upgradeResourceIdentity(addr, provider, src, schema)
+		if !diags.HasErrors() {
+			t.Fatal("expected an error, but there was none")
+		}
+		if provider.UpgradeResourceIdentityCalled {
+			t.Fatal("expected the provider not to be called for a downgrade")
+		}
+	})
+
+	t.Run("migrates the identity using the provider when versions differ", func(t *testing.T) {
+		src := &states.ResourceInstanceObjectSrc{
+			IdentitySchemaVersion: 1,
+			IdentityJSON:          encodeIdentity(cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("legacy-id")})),
+		}
+		provider := &testing_provider.MockProvider{
+			ConfigureProviderCalled: true,
+			UpgradeResourceIdentityFn: func(req providers.UpgradeResourceIdentityRequest) providers.UpgradeResourceIdentityResponse {
+				if req.Version != 1 {
+					t.Fatalf("wrong prior version sent to provider: %d", req.Version)
+				}
+				return providers.UpgradeResourceIdentityResponse{
+					UpgradedIdentity: cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("legacy-id")}),
+				}
+			},
+		}
+
+		got, diags := upgradeResourceIdentity(addr, provider, src, schema)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+		if !provider.UpgradeResourceIdentityCalled {
+			t.Fatal("expected the provider's UpgradeResourceIdentity to be called")
+		}
+		if got.IdentitySchemaVersion != 2 {
+			t.Fatalf("wrong identity schema version after upgrade: got %d, want 2", got.IdentitySchemaVersion)
+		}
+
+		decoded, err := ctyjson.Unmarshal(got.IdentityJSON, identityType)
+		if err != nil {
+			t.Fatalf("failed to decode upgraded identity: %s", err)
+		}
+		if !decoded.RawEquals(cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("legacy-id")})) {
+			t.Fatalf("wrong upgraded identity: %#v", decoded)
+		}
+	})
+}